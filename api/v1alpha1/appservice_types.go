@@ -18,12 +18,75 @@ type ResourceRequirements struct {
 }
 
 type AppServiceStatus struct {
-	Phase             string             `json:"phase"`
-	AvailableReplicas int32              `json:"availableReplicas"`
-	Conditions        []metav1.Condition `json:"conditions,omitempty"`
-	LastReconcileTime metav1.Time        `json:"lastReconcileTime,omitempty"`
+	Phase             string               `json:"phase"`
+	AvailableReplicas int32                `json:"availableReplicas"`
+	Conditions        []metav1.Condition   `json:"conditions,omitempty"`
+	LastReconcileTime metav1.Time          `json:"lastReconcileTime,omitempty"`
+	ResourceBundle    ResourceBundleStatus `json:"resourceBundle,omitempty"`
+
+	// PropagatedClusters reports, for each cluster an AppServicePropagationPolicy
+	// targets this AppService at, whether its Work was applied successfully.
+	// It's kept in sync by the Work status-aggregation controller.
+	PropagatedClusters []PropagatedClusterStatus `json:"propagatedClusters,omitempty"`
+}
+
+// PropagatedClusterStatus is one cluster's propagation state for an
+// AppService, aggregated from the corresponding Work object's status.
+type PropagatedClusterStatus struct {
+	ClusterName string `json:"clusterName"`
+	Applied     bool   `json:"applied"`
+	Message     string `json:"message,omitempty"`
+}
+
+// ResourceBundleStatus aggregates the live state of every resource the
+// AppService owns, so `kubectl get appservice -o yaml` shows the whole
+// bundle without cross-referencing Deployments/Services/Pods by hand. Each
+// per-kind controller in controllers/ owns one of these slices and keeps its
+// entries in sync by object name.
+type ResourceBundleStatus struct {
+	Deployments []DeploymentStatus `json:"deployments,omitempty"`
+	Services    []ServiceStatus    `json:"services,omitempty"`
+	Pods        []PodStatus        `json:"pods,omitempty"`
+	ConfigMaps  []ConfigMapStatus  `json:"configMaps,omitempty"`
+}
+
+// DeploymentStatus is a trimmed-down view of appsv1.DeploymentStatus for one
+// Deployment owned by the AppService.
+type DeploymentStatus struct {
+	Name              string `json:"name"`
+	Replicas          int32  `json:"replicas"`
+	ReadyReplicas     int32  `json:"readyReplicas"`
+	AvailableReplicas int32  `json:"availableReplicas"`
+	UpdatedReplicas   int32  `json:"updatedReplicas"`
 }
 
+// ServiceStatus is a trimmed-down view of one Service owned by the
+// AppService.
+type ServiceStatus struct {
+	Name      string `json:"name"`
+	Type      string `json:"type,omitempty"`
+	ClusterIP string `json:"clusterIP,omitempty"`
+}
+
+// PodStatus is a trimmed-down view of one Pod owned by the AppService.
+type PodStatus struct {
+	Name  string `json:"name"`
+	Phase string `json:"phase"`
+	Ready bool   `json:"ready"`
+}
+
+// ConfigMapStatus records that a ConfigMap is part of the AppService's
+// resource bundle. ConfigMaps carry no interesting status of their own, so
+// this is little more than a presence marker plus enough detail to notice
+// that its contents changed.
+type ConfigMapStatus struct {
+	Name            string `json:"name"`
+	ResourceVersion string `json:"resourceVersion,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
 type AppService struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
@@ -31,6 +94,8 @@ type AppService struct {
 	Status            AppServiceStatus `json:"status,omitempty"`
 }
 
+// +kubebuilder:object:root=true
+
 type AppServiceList struct {
 	metav1.TypeMeta `json:",inline"`
 	metav1.ListMeta `json:"metadata,omitempty"`
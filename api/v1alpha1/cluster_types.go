@@ -0,0 +1,49 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// SecretReference points at the Secret holding a cluster's kubeconfig.
+type SecretReference struct {
+	Name string `json:"name"`
+	// Namespace defaults to the Cluster's own namespace when empty.
+	Namespace string `json:"namespace,omitempty"`
+	// Key is the Secret data key holding the kubeconfig bytes. Defaults to
+	// "kubeconfig" when empty.
+	Key string `json:"key,omitempty"`
+}
+
+// ClusterSpec identifies a member cluster by where to find its kubeconfig.
+type ClusterSpec struct {
+	KubeconfigSecretRef SecretReference `json:"kubeconfigSecretRef"`
+}
+
+type ClusterStatus struct {
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// Cluster registers a member cluster that AppServices can be propagated to.
+// Its kubeconfig lives in a Secret rather than inline, so RBAC on the Secret
+// controls who can reach the member cluster.
+type Cluster struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              ClusterSpec   `json:"spec"`
+	Status            ClusterStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+type ClusterList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Cluster `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&Cluster{}, &ClusterList{})
+}
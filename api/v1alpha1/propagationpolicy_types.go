@@ -0,0 +1,59 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ResourceSelector identifies the resources an AppServicePropagationPolicy
+// applies to. Name selects a single object by name; an empty Name would
+// select every object of Kind, but only name-based selection is implemented
+// so far.
+type ResourceSelector struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	Name       string `json:"name,omitempty"`
+}
+
+// ClusterAffinity lists the clusters a policy's resources should be
+// propagated to, by their Cluster object name.
+type ClusterAffinity struct {
+	ClusterNames []string `json:"clusterNames,omitempty"`
+}
+
+type Placement struct {
+	ClusterAffinity ClusterAffinity `json:"clusterAffinity,omitempty"`
+}
+
+type AppServicePropagationPolicySpec struct {
+	ResourceSelectors []ResourceSelector `json:"resourceSelectors"`
+	Placement         Placement          `json:"placement"`
+}
+
+type AppServicePropagationPolicyStatus struct {
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// AppServicePropagationPolicy selects AppServices (and, in time, other
+// resource kinds) and propagates their rendered manifests as Work objects to
+// every cluster named under Spec.Placement.ClusterAffinity.
+type AppServicePropagationPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              AppServicePropagationPolicySpec   `json:"spec"`
+	Status            AppServicePropagationPolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+type AppServicePropagationPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AppServicePropagationPolicy `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&AppServicePropagationPolicy{}, &AppServicePropagationPolicyList{})
+}
@@ -0,0 +1,45 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// WorkSpec holds the manifests to apply to a Work's target cluster. Each
+// entry is the JSON encoding of one object (e.g. a Deployment or Service).
+type WorkSpec struct {
+	Manifests []runtime.RawExtension `json:"manifests,omitempty"`
+}
+
+type WorkStatus struct {
+	// Conditions reports the outcome of applying Spec.Manifests to the
+	// target cluster, e.g. a "Applied" condition set by whatever reconciles
+	// this Work against its cluster.
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// Work is the rendered, per-cluster form of a propagated AppService: the
+// manifests an AppServicePropagationPolicy selected, bound to one target
+// cluster. Mirrors the execution-space Work object Karmada uses to carry
+// workloads from its control plane out to member clusters.
+type Work struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              WorkSpec   `json:"spec"`
+	Status            WorkStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+type WorkList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Work `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&Work{}, &WorkList{})
+}
@@ -9,19 +9,28 @@ import (
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
-	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	examplev1alpha1 "github.com/example/k8s-operator/api/v1alpha1"
+	opbuilder "github.com/example/k8s-operator/pkg/builder"
+	"github.com/example/k8s-operator/pkg/reconciler"
 )
 
+// appServiceFieldManager identifies this controller to the API server for
+// server-side apply, so field ownership (e.g. an HPA's claim on
+// Deployment.Spec.Replicas) is tracked per-manager instead of being
+// reclaimed by whichever controller last wrote the object.
+const appServiceFieldManager = "appservice-controller"
+
 type AppServiceReconciler struct {
 	client.Client
-	Scheme *runtime.Scheme
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
 }
 
 func (r *AppServiceReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
@@ -55,39 +64,30 @@ func (r *AppServiceReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		return ctrl.Result{}, nil
 	}
 
-	deployment := &appsv1.Deployment{}
-	err := r.Get(ctx, types.NamespacedName{Name: appService.Name, Namespace: appService.Namespace}, deployment)
-	if err != nil && errors.IsNotFound(err) {
-		dep := r.deploymentForAppService(appService)
-		logger.Info("Creating Deployment", "Deployment.Namespace", dep.Namespace, "Deployment.Name", dep.Name)
-		if err = r.Create(ctx, dep); err != nil {
-			return ctrl.Result{}, err
-		}
-		return ctrl.Result{Requeue: true}, nil
-	} else if err != nil {
+	base := &reconciler.BaseReconciler{Client: r.Client, Scheme: r.Scheme}
+
+	deployment, depResult, err := r.applyDeployment(ctx, base, appService)
+	if err != nil {
 		return ctrl.Result{}, err
 	}
-
-	if *deployment.Spec.Replicas != appService.Spec.Replicas {
-		deployment.Spec.Replicas = &appService.Spec.Replicas
-		if err = r.Update(ctx, deployment); err != nil {
-			return ctrl.Result{}, err
-		}
+	if depResult == reconciler.OperationResultCreated {
+		logger.Info("Created Deployment", "Deployment.Namespace", deployment.Namespace, "Deployment.Name", deployment.Name)
+		reconciler.RecordEvent(r.Recorder, appService, "Normal", "DeploymentCreated", "Created Deployment "+deployment.Name)
 		return ctrl.Result{Requeue: true}, nil
 	}
 
-	service := &corev1.Service{}
-	err = r.Get(ctx, types.NamespacedName{Name: appService.Name, Namespace: appService.Namespace}, service)
-	if err != nil && errors.IsNotFound(err) {
-		svc := r.serviceForAppService(appService)
-		logger.Info("Creating Service", "Service.Namespace", svc.Namespace, "Service.Name", svc.Name)
-		if err = r.Create(ctx, svc); err != nil {
-			return ctrl.Result{}, err
-		}
-		return ctrl.Result{Requeue: true}, nil
-	} else if err != nil {
+	service := &corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: appService.Name, Namespace: appService.Namespace}}
+	svcResult, err := base.CreateOrPatch(ctx, service, func() error {
+		return r.mutateService(appService, service)
+	})
+	if err != nil {
 		return ctrl.Result{}, err
 	}
+	if svcResult == reconciler.OperationResultCreated {
+		logger.Info("Created Service", "Service.Namespace", service.Namespace, "Service.Name", service.Name)
+		reconciler.RecordEvent(r.Recorder, appService, "Normal", "ServiceCreated", "Created Service "+service.Name)
+		return ctrl.Result{Requeue: true}, nil
+	}
 
 	appService.Status.AvailableReplicas = deployment.Status.AvailableReplicas
 	appService.Status.LastReconcileTime = metav1.Now()
@@ -107,9 +107,38 @@ func (r *AppServiceReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 	return ctrl.Result{RequeueAfter: 30 * time.Second}, nil
 }
 
-func (r *AppServiceReconciler) deploymentForAppService(app *examplev1alpha1.AppService) *appsv1.Deployment {
+// applyDeployment reconciles app's Deployment via server-side apply rather
+// than CreateOrPatch. SSA lets the API server track field ownership per
+// manager, so omitting Spec.Replicas from the applied object - which this
+// does on every reconcile after the first - leaves it alone for whichever
+// manager currently owns it (e.g. an HPA), instead of stomping it back to
+// appService.Spec.Replicas every 30s.
+func (r *AppServiceReconciler) applyDeployment(ctx context.Context, base *reconciler.BaseReconciler, app *examplev1alpha1.AppService) (*appsv1.Deployment, reconciler.OperationResult, error) {
+	existing := &appsv1.Deployment{}
+	err := r.Get(ctx, client.ObjectKey{Name: app.Name, Namespace: app.Namespace}, existing)
+	if err != nil && !errors.IsNotFound(err) {
+		return nil, reconciler.OperationResultNone, err
+	}
+	isNew := errors.IsNotFound(err)
+
+	dep := r.deploymentForAppService(app, isNew)
+	if err := base.Apply(ctx, dep, appServiceFieldManager); err != nil {
+		return nil, reconciler.OperationResultNone, err
+	}
+
+	if isNew {
+		return dep, reconciler.OperationResultCreated, nil
+	}
+	return dep, reconciler.OperationResultUpdated, nil
+}
+
+// deploymentForAppService builds the Deployment this controller applies for
+// app. Spec.Replicas is only included when setInitialReplicas is true (i.e.
+// the Deployment doesn't exist yet); leaving it nil afterwards means the
+// applied object doesn't claim that field, so server-side apply won't
+// reassert it over a later owner like an HPA.
+func (r *AppServiceReconciler) deploymentForAppService(app *examplev1alpha1.AppService, setInitialReplicas bool) *appsv1.Deployment {
 	labels := map[string]string{"app": app.Name}
-	replicas := app.Spec.Replicas
 
 	containers := []corev1.Container{{
 		Name:  app.Name,
@@ -136,9 +165,10 @@ func (r *AppServiceReconciler) deploymentForAppService(app *examplev1alpha1.AppS
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      app.Name,
 			Namespace: app.Namespace,
+			Labels:    labels,
 		},
+		TypeMeta: metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
 		Spec: appsv1.DeploymentSpec{
-			Replicas: &replicas,
 			Selector: &metav1.LabelSelector{MatchLabels: labels},
 			Template: corev1.PodTemplateSpec{
 				ObjectMeta: metav1.ObjectMeta{Labels: labels},
@@ -146,31 +176,32 @@ func (r *AppServiceReconciler) deploymentForAppService(app *examplev1alpha1.AppS
 			},
 		},
 	}
+	if setInitialReplicas {
+		replicas := app.Spec.Replicas
+		dep.Spec.Replicas = &replicas
+	}
 
 	controllerutil.SetControllerReference(app, dep, r.Scheme)
 	return dep
 }
 
-func (r *AppServiceReconciler) serviceForAppService(app *examplev1alpha1.AppService) *corev1.Service {
+// mutateService reconciles svc's spec to match app. It's invoked by
+// CreateOrPatch with svc already holding either a blank, freshly-keyed
+// object (on create) or the cluster's current state (on update).
+func (r *AppServiceReconciler) mutateService(app *examplev1alpha1.AppService, svc *corev1.Service) error {
 	labels := map[string]string{"app": app.Name}
 
-	svc := &corev1.Service{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      app.Name,
-			Namespace: app.Namespace,
-		},
-		Spec: corev1.ServiceSpec{
-			Selector: labels,
-			Ports: []corev1.ServicePort{{
-				Port:       app.Spec.Port,
-				TargetPort: intstr.FromInt(int(app.Spec.Port)),
-			}},
-			Type: corev1.ServiceTypeClusterIP,
-		},
+	svc.Labels = labels
+	svc.Spec.Selector = labels
+	svc.Spec.Ports = []corev1.ServicePort{{
+		Port:       app.Spec.Port,
+		TargetPort: intstr.FromInt(int(app.Spec.Port)),
+	}}
+	if svc.Spec.Type == "" {
+		svc.Spec.Type = corev1.ServiceTypeClusterIP
 	}
 
-	controllerutil.SetControllerReference(app, svc, r.Scheme)
-	return svc
+	return controllerutil.SetControllerReference(app, svc, r.Scheme)
 }
 
 func (r *AppServiceReconciler) updateCondition(app *examplev1alpha1.AppService, condType string, status metav1.ConditionStatus, reason, message string) {
@@ -200,10 +231,14 @@ func (r *AppServiceReconciler) cleanupResources(ctx context.Context, app *exampl
 }
 
 func (r *AppServiceReconciler) SetupWithManager(mgr ctrl.Manager) error {
-	return ctrl.NewControllerManagedBy(mgr).
+	return opbuilder.ControllerManagedBy(mgr).
 		For(&examplev1alpha1.AppService{}).
 		Owns(&appsv1.Deployment{}).
 		Owns(&corev1.Service{}).
+		// Pods are only watched to notice churn and trigger a status
+		// recompute; we never read their spec, so there's no reason to pay
+		// for a fully-hydrated Pod cache on top of the Deployment one.
+		Owns(&corev1.Pod{}, opbuilder.OnlyMetadata).
 		Complete(r)
 }
 
@@ -0,0 +1,87 @@
+package controllers
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	examplev1alpha1 "github.com/example/k8s-operator/api/v1alpha1"
+)
+
+// ConfigMapStatusReconciler watches ConfigMaps labelled app=<AppService.Name>
+// and keeps AppServiceStatus.ResourceBundle.ConfigMaps in sync. ConfigMaps
+// are optional members of the bundle: an AppService doesn't have to mount
+// one, but if it does, it shows up here like everything else.
+type ConfigMapStatusReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+}
+
+func (r *ConfigMapStatusReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	configMap := &corev1.ConfigMap{}
+	if err := r.Get(ctx, req.NamespacedName, configMap); err != nil {
+		if errors.IsNotFound(err) {
+			return ctrl.Result{}, r.removeFromResourceBundle(ctx, req.NamespacedName)
+		}
+		return ctrl.Result{}, err
+	}
+
+	appService, err := findOwningAppService(ctx, r.Client, configMap)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if appService == nil {
+		return ctrl.Result{}, nil
+	}
+
+	patch := client.MergeFrom(appService.DeepCopy())
+	appService.Status.ResourceBundle.ConfigMaps = upsertConfigMapStatus(
+		appService.Status.ResourceBundle.ConfigMaps,
+		examplev1alpha1.ConfigMapStatus{
+			Name:            configMap.Name,
+			ResourceVersion: configMap.ResourceVersion,
+		},
+	)
+
+	if err := r.Status().Patch(ctx, appService, patch); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	logger.V(1).Info("Updated resource bundle", "ConfigMap", configMap.Name, "AppService", appService.Name)
+	return ctrl.Result{}, nil
+}
+
+// removeFromResourceBundle drops key.Name's entry from whichever AppService
+// in key.Namespace still lists it, since the deleted ConfigMap no longer
+// carries the "app" label findOwningAppService needs to find it directly.
+func (r *ConfigMapStatusReconciler) removeFromResourceBundle(ctx context.Context, key client.ObjectKey) error {
+	appService, err := findAppServiceOwningBundleEntry(ctx, r.Client, key.Namespace, func(app examplev1alpha1.AppService) bool {
+		for _, c := range app.Status.ResourceBundle.ConfigMaps {
+			if c.Name == key.Name {
+				return true
+			}
+		}
+		return false
+	})
+	if err != nil || appService == nil {
+		return err
+	}
+
+	patch := client.MergeFrom(appService.DeepCopy())
+	appService.Status.ResourceBundle.ConfigMaps = removeConfigMapStatus(appService.Status.ResourceBundle.ConfigMaps, key.Name)
+	return r.Status().Patch(ctx, appService, patch)
+}
+
+func (r *ConfigMapStatusReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&corev1.ConfigMap{}).
+		WithEventFilter(HasAppLabel()).
+		Complete(r)
+}
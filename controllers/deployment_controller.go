@@ -0,0 +1,90 @@
+package controllers
+
+import (
+	"context"
+
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	examplev1alpha1 "github.com/example/k8s-operator/api/v1alpha1"
+)
+
+// DeploymentStatusReconciler watches Deployments labelled app=<AppService.Name>
+// and keeps AppServiceStatus.ResourceBundle.Deployments in sync, so the
+// AppService's status reflects every Deployment it owns without the main
+// AppServiceReconciler having to poll for them.
+type DeploymentStatusReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+}
+
+func (r *DeploymentStatusReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	deployment := &appsv1.Deployment{}
+	if err := r.Get(ctx, req.NamespacedName, deployment); err != nil {
+		if errors.IsNotFound(err) {
+			return ctrl.Result{}, r.removeFromResourceBundle(ctx, req.NamespacedName)
+		}
+		return ctrl.Result{}, err
+	}
+
+	appService, err := findOwningAppService(ctx, r.Client, deployment)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if appService == nil {
+		return ctrl.Result{}, nil
+	}
+
+	patch := client.MergeFrom(appService.DeepCopy())
+	appService.Status.ResourceBundle.Deployments = upsertDeploymentStatus(
+		appService.Status.ResourceBundle.Deployments,
+		examplev1alpha1.DeploymentStatus{
+			Name:              deployment.Name,
+			Replicas:          deployment.Status.Replicas,
+			ReadyReplicas:     deployment.Status.ReadyReplicas,
+			AvailableReplicas: deployment.Status.AvailableReplicas,
+			UpdatedReplicas:   deployment.Status.UpdatedReplicas,
+		},
+	)
+
+	if err := r.Status().Patch(ctx, appService, patch); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	logger.V(1).Info("Updated resource bundle", "Deployment", deployment.Name, "AppService", appService.Name)
+	return ctrl.Result{}, nil
+}
+
+// removeFromResourceBundle drops key.Name's entry from whichever AppService
+// in key.Namespace still lists it, since the deleted Deployment no longer
+// carries the "app" label findOwningAppService needs to find it directly.
+func (r *DeploymentStatusReconciler) removeFromResourceBundle(ctx context.Context, key client.ObjectKey) error {
+	appService, err := findAppServiceOwningBundleEntry(ctx, r.Client, key.Namespace, func(app examplev1alpha1.AppService) bool {
+		for _, d := range app.Status.ResourceBundle.Deployments {
+			if d.Name == key.Name {
+				return true
+			}
+		}
+		return false
+	})
+	if err != nil || appService == nil {
+		return err
+	}
+
+	patch := client.MergeFrom(appService.DeepCopy())
+	appService.Status.ResourceBundle.Deployments = removeDeploymentStatus(appService.Status.ResourceBundle.Deployments, key.Name)
+	return r.Status().Patch(ctx, appService, patch)
+}
+
+func (r *DeploymentStatusReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&appsv1.Deployment{}).
+		WithEventFilter(HasAppLabel()).
+		Complete(r)
+}
@@ -0,0 +1,95 @@
+package controllers
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	examplev1alpha1 "github.com/example/k8s-operator/api/v1alpha1"
+)
+
+// PodStatusReconciler watches Pods labelled app=<AppService.Name> and keeps
+// AppServiceStatus.ResourceBundle.Pods in sync.
+type PodStatusReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+}
+
+func (r *PodStatusReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	pod := &corev1.Pod{}
+	if err := r.Get(ctx, req.NamespacedName, pod); err != nil {
+		if errors.IsNotFound(err) {
+			return ctrl.Result{}, r.removeFromResourceBundle(ctx, req.NamespacedName)
+		}
+		return ctrl.Result{}, err
+	}
+
+	appService, err := findOwningAppService(ctx, r.Client, pod)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if appService == nil {
+		return ctrl.Result{}, nil
+	}
+
+	patch := client.MergeFrom(appService.DeepCopy())
+	appService.Status.ResourceBundle.Pods = upsertPodStatus(
+		appService.Status.ResourceBundle.Pods,
+		examplev1alpha1.PodStatus{
+			Name:  pod.Name,
+			Phase: string(pod.Status.Phase),
+			Ready: isPodReady(pod),
+		},
+	)
+
+	if err := r.Status().Patch(ctx, appService, patch); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	logger.V(1).Info("Updated resource bundle", "Pod", pod.Name, "AppService", appService.Name)
+	return ctrl.Result{}, nil
+}
+
+func isPodReady(pod *corev1.Pod) bool {
+	for _, cond := range pod.Status.Conditions {
+		if cond.Type == corev1.PodReady {
+			return cond.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
+
+// removeFromResourceBundle drops key.Name's entry from whichever AppService
+// in key.Namespace still lists it, since the deleted Pod no longer carries
+// the "app" label findOwningAppService needs to find it directly.
+func (r *PodStatusReconciler) removeFromResourceBundle(ctx context.Context, key client.ObjectKey) error {
+	appService, err := findAppServiceOwningBundleEntry(ctx, r.Client, key.Namespace, func(app examplev1alpha1.AppService) bool {
+		for _, p := range app.Status.ResourceBundle.Pods {
+			if p.Name == key.Name {
+				return true
+			}
+		}
+		return false
+	})
+	if err != nil || appService == nil {
+		return err
+	}
+
+	patch := client.MergeFrom(appService.DeepCopy())
+	appService.Status.ResourceBundle.Pods = removePodStatus(appService.Status.ResourceBundle.Pods, key.Name)
+	return r.Status().Patch(ctx, appService, patch)
+}
+
+func (r *PodStatusReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&corev1.Pod{}).
+		WithEventFilter(HasAppLabel()).
+		Complete(r)
+}
@@ -0,0 +1,16 @@
+package controllers
+
+import (
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+)
+
+// HasAppLabel filters events down to objects carrying the "app" label the
+// resource-bundle controllers use to find their owning AppService, so we
+// don't wake a reconciler for objects no AppService will ever claim.
+func HasAppLabel() predicate.Predicate {
+	return predicate.NewPredicateFuncs(func(obj client.Object) bool {
+		name, ok := obj.GetLabels()[appLabelKey]
+		return ok && name != ""
+	})
+}
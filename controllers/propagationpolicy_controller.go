@@ -0,0 +1,278 @@
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	examplev1alpha1 "github.com/example/k8s-operator/api/v1alpha1"
+	"github.com/example/k8s-operator/pkg/k8s"
+	"github.com/example/k8s-operator/pkg/reconciler"
+)
+
+// clusterLabelKey labels a Work object with the name of the Cluster it was
+// pushed to, so WorkStatusReconciler knows which entry of
+// AppServiceStatus.PropagatedClusters it reports.
+const clusterLabelKey = "example.com/cluster"
+
+// AppServicePropagationReconciler watches AppServicePropagationPolicy
+// objects and, for each ResourceSelector that names an AppService, renders
+// its Deployment/Service manifests, records them in a per-cluster Work
+// object, and applies them to every cluster named under
+// Spec.Placement.ClusterAffinity.
+type AppServicePropagationReconciler struct {
+	client.Client
+	Scheme   *runtime.Scheme
+	Clusters *k8s.ClusterClientCache
+}
+
+func (r *AppServicePropagationReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	policy := &examplev1alpha1.AppServicePropagationPolicy{}
+	if err := r.Get(ctx, req.NamespacedName, policy); err != nil {
+		if errors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	clusterNames := policy.Spec.Placement.ClusterAffinity.ClusterNames
+	if len(clusterNames) == 0 {
+		return ctrl.Result{}, nil
+	}
+
+	var errs []error
+	for _, sel := range policy.Spec.ResourceSelectors {
+		if sel.Kind != "AppService" {
+			logger.V(1).Info("Skipping unsupported resource selector", "kind", sel.Kind)
+			continue
+		}
+
+		appService := &examplev1alpha1.AppService{}
+		key := client.ObjectKey{Name: sel.Name, Namespace: policy.Namespace}
+		if err := r.Get(ctx, key, appService); err != nil {
+			if errors.IsNotFound(err) {
+				continue
+			}
+			errs = append(errs, err)
+			continue
+		}
+
+		manifests, err := manifestsForAppService(appService)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to render manifests for AppService %q: %w", appService.Name, err))
+			continue
+		}
+
+		for _, clusterName := range clusterNames {
+			if err := r.syncCluster(ctx, policy.Namespace, clusterName, appService, manifests); err != nil {
+				logger.Error(err, "Failed to propagate AppService", "AppService", appService.Name, "cluster", clusterName)
+				errs = append(errs, err)
+			}
+		}
+	}
+
+	if err := utilerrors.NewAggregate(errs); err != nil {
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{}, nil
+}
+
+// syncCluster records manifests in clusterName's Work object and applies
+// them to the cluster itself, then reflects the outcome in the Work's
+// Applied condition.
+func (r *AppServicePropagationReconciler) syncCluster(ctx context.Context, policyNamespace, clusterName string, app *examplev1alpha1.AppService, manifests []runtime.RawExtension) error {
+	base := &reconciler.BaseReconciler{Client: r.Client, Scheme: r.Scheme}
+
+	work := &examplev1alpha1.Work{ObjectMeta: metav1.ObjectMeta{
+		Name:      workName(app.Name, clusterName),
+		Namespace: app.Namespace,
+	}}
+	if _, err := base.CreateOrPatch(ctx, work, func() error {
+		work.Labels = map[string]string{appLabelKey: app.Name, clusterLabelKey: clusterName}
+		work.Spec.Manifests = manifests
+		return controllerutil.SetControllerReference(app, work, r.Scheme)
+	}); err != nil {
+		return fmt.Errorf("failed to record Work for cluster %q: %w", clusterName, err)
+	}
+
+	applyErr := r.applyToCluster(ctx, policyNamespace, clusterName, app)
+
+	patch := client.MergeFrom(work.DeepCopy())
+	setWorkAppliedCondition(work, applyErr)
+	if err := r.Status().Patch(ctx, work, patch); err != nil {
+		return fmt.Errorf("failed to update Work status for cluster %q: %w", clusterName, err)
+	}
+	return applyErr
+}
+
+func (r *AppServicePropagationReconciler) applyToCluster(ctx context.Context, policyNamespace, clusterName string, app *examplev1alpha1.AppService) error {
+	remote, err := r.Clusters.Get(ctx, policyNamespace, clusterName)
+	if err != nil {
+		return fmt.Errorf("failed to get client for cluster %q: %w", clusterName, err)
+	}
+	if err := remote.ApplyDeployment(ctx, renderDeploymentManifest(app)); err != nil {
+		return fmt.Errorf("failed to apply Deployment: %w", err)
+	}
+	if err := remote.ApplyService(ctx, renderServiceManifest(app)); err != nil {
+		return fmt.Errorf("failed to apply Service: %w", err)
+	}
+	return nil
+}
+
+func (r *AppServicePropagationReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&examplev1alpha1.AppServicePropagationPolicy{}).
+		Watches(
+			&examplev1alpha1.AppService{},
+			handler.EnqueueRequestsFromMapFunc(r.mapAppServiceToPolicies),
+		).
+		Complete(r)
+}
+
+// mapAppServiceToPolicies enqueues every AppServicePropagationPolicy in
+// appService's namespace whose ResourceSelectors name it, so edits to an
+// AppService re-propagate it without waiting for its policy to change.
+func (r *AppServicePropagationReconciler) mapAppServiceToPolicies(ctx context.Context, appService client.Object) []ctrl.Request {
+	policies := &examplev1alpha1.AppServicePropagationPolicyList{}
+	if err := r.List(ctx, policies, client.InNamespace(appService.GetNamespace())); err != nil {
+		return nil
+	}
+
+	var requests []ctrl.Request
+	for _, policy := range policies.Items {
+		for _, sel := range policy.Spec.ResourceSelectors {
+			if sel.Kind == "AppService" && sel.Name == appService.GetName() {
+				requests = append(requests, ctrl.Request{
+					NamespacedName: client.ObjectKeyFromObject(&policy),
+				})
+				break
+			}
+		}
+	}
+	return requests
+}
+
+// workName derives a Work object's name from the AppService and cluster it
+// targets, since one AppService can be propagated to several clusters.
+func workName(appServiceName, clusterName string) string {
+	return appServiceName + "-" + clusterName
+}
+
+func setWorkAppliedCondition(work *examplev1alpha1.Work, applyErr error) {
+	condition := metav1.Condition{
+		Type:               "Applied",
+		LastTransitionTime: metav1.Now(),
+	}
+	if applyErr != nil {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = "ApplyFailed"
+		condition.Message = applyErr.Error()
+	} else {
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = "ApplySucceeded"
+		condition.Message = "manifests applied to cluster"
+	}
+
+	for i, c := range work.Status.Conditions {
+		if c.Type == condition.Type {
+			if c.Status != condition.Status {
+				work.Status.Conditions[i] = condition
+			}
+			return
+		}
+	}
+	work.Status.Conditions = append(work.Status.Conditions, condition)
+}
+
+// renderDeploymentManifest builds the Deployment a propagated AppService
+// runs as on a member cluster. It deliberately doesn't set a controller
+// reference - the AppService it's rendered from lives on the hub cluster,
+// not the member cluster the Deployment is applied to.
+func renderDeploymentManifest(app *examplev1alpha1.AppService) *appsv1.Deployment {
+	labels := map[string]string{"app": app.Name}
+
+	containers := []corev1.Container{{
+		Name:  app.Name,
+		Image: app.Spec.Image,
+		Ports: []corev1.ContainerPort{{ContainerPort: app.Spec.Port}},
+	}}
+
+	if len(app.Spec.Environment) > 0 {
+		envVars := []corev1.EnvVar{}
+		for k, v := range app.Spec.Environment {
+			envVars = append(envVars, corev1.EnvVar{Name: k, Value: v})
+		}
+		containers[0].Env = envVars
+	}
+
+	replicas := app.Spec.Replicas
+	return &appsv1.Deployment{
+		TypeMeta: metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      app.Name,
+			Namespace: app.Namespace,
+			Labels:    labels,
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: &replicas,
+			Selector: &metav1.LabelSelector{MatchLabels: labels},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: labels},
+				Spec:       corev1.PodSpec{Containers: containers},
+			},
+		},
+	}
+}
+
+// renderServiceManifest builds the Service a propagated AppService runs as
+// on a member cluster.
+func renderServiceManifest(app *examplev1alpha1.AppService) *corev1.Service {
+	labels := map[string]string{"app": app.Name}
+
+	return &corev1.Service{
+		TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "Service"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      app.Name,
+			Namespace: app.Namespace,
+		},
+		Spec: corev1.ServiceSpec{
+			Selector: labels,
+			Ports: []corev1.ServicePort{{
+				Port:       app.Spec.Port,
+				TargetPort: intstr.FromInt(int(app.Spec.Port)),
+			}},
+			Type: corev1.ServiceTypeClusterIP,
+		},
+	}
+}
+
+// manifestsForAppService renders app's Deployment and Service and encodes
+// each as a Work manifest entry.
+func manifestsForAppService(app *examplev1alpha1.AppService) ([]runtime.RawExtension, error) {
+	objs := []runtime.Object{renderDeploymentManifest(app), renderServiceManifest(app)}
+
+	manifests := make([]runtime.RawExtension, 0, len(objs))
+	for _, obj := range objs {
+		data, err := json.Marshal(obj)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode manifest %T: %w", obj, err)
+		}
+		manifests = append(manifests, runtime.RawExtension{Raw: data})
+	}
+	return manifests, nil
+}
@@ -0,0 +1,146 @@
+package controllers
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	examplev1alpha1 "github.com/example/k8s-operator/api/v1alpha1"
+)
+
+// appLabelKey is the label the per-kind resource-bundle controllers use to
+// find the AppService that owns an object: app=<AppService.Name>.
+const appLabelKey = "app"
+
+// findOwningAppService looks up the AppService named by obj's "app" label in
+// the same namespace. It returns (nil, nil) when the label is absent or the
+// AppService no longer exists, which callers treat as "nothing to do".
+func findOwningAppService(ctx context.Context, c client.Client, obj client.Object) (*examplev1alpha1.AppService, error) {
+	name, ok := obj.GetLabels()[appLabelKey]
+	if !ok || name == "" {
+		return nil, nil
+	}
+
+	appService := &examplev1alpha1.AppService{}
+	key := client.ObjectKey{Name: name, Namespace: obj.GetNamespace()}
+	if err := c.Get(ctx, key, appService); err != nil {
+		if errors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return appService, nil
+}
+
+// findAppServiceOwningBundleEntry scans every AppService in namespace for
+// one whose ResourceBundle still references an entry, per has. Used on
+// NotFound, when the deleted object no longer carries the "app" label
+// findOwningAppService needs to find it directly.
+func findAppServiceOwningBundleEntry(ctx context.Context, c client.Client, namespace string, has func(examplev1alpha1.AppService) bool) (*examplev1alpha1.AppService, error) {
+	appServices := &examplev1alpha1.AppServiceList{}
+	if err := c.List(ctx, appServices, client.InNamespace(namespace)); err != nil {
+		return nil, err
+	}
+	for i := range appServices.Items {
+		if has(appServices.Items[i]) {
+			return &appServices.Items[i], nil
+		}
+	}
+	return nil, nil
+}
+
+func upsertDeploymentStatus(list []examplev1alpha1.DeploymentStatus, status examplev1alpha1.DeploymentStatus) []examplev1alpha1.DeploymentStatus {
+	for i := range list {
+		if list[i].Name == status.Name {
+			list[i] = status
+			return list
+		}
+	}
+	return append(list, status)
+}
+
+func removeDeploymentStatus(list []examplev1alpha1.DeploymentStatus, name string) []examplev1alpha1.DeploymentStatus {
+	for i := range list {
+		if list[i].Name == name {
+			return append(list[:i], list[i+1:]...)
+		}
+	}
+	return list
+}
+
+func upsertServiceStatus(list []examplev1alpha1.ServiceStatus, status examplev1alpha1.ServiceStatus) []examplev1alpha1.ServiceStatus {
+	for i := range list {
+		if list[i].Name == status.Name {
+			list[i] = status
+			return list
+		}
+	}
+	return append(list, status)
+}
+
+func removeServiceStatus(list []examplev1alpha1.ServiceStatus, name string) []examplev1alpha1.ServiceStatus {
+	for i := range list {
+		if list[i].Name == name {
+			return append(list[:i], list[i+1:]...)
+		}
+	}
+	return list
+}
+
+func upsertPodStatus(list []examplev1alpha1.PodStatus, status examplev1alpha1.PodStatus) []examplev1alpha1.PodStatus {
+	for i := range list {
+		if list[i].Name == status.Name {
+			list[i] = status
+			return list
+		}
+	}
+	return append(list, status)
+}
+
+func removePodStatus(list []examplev1alpha1.PodStatus, name string) []examplev1alpha1.PodStatus {
+	for i := range list {
+		if list[i].Name == name {
+			return append(list[:i], list[i+1:]...)
+		}
+	}
+	return list
+}
+
+func upsertConfigMapStatus(list []examplev1alpha1.ConfigMapStatus, status examplev1alpha1.ConfigMapStatus) []examplev1alpha1.ConfigMapStatus {
+	for i := range list {
+		if list[i].Name == status.Name {
+			list[i] = status
+			return list
+		}
+	}
+	return append(list, status)
+}
+
+func removeConfigMapStatus(list []examplev1alpha1.ConfigMapStatus, name string) []examplev1alpha1.ConfigMapStatus {
+	for i := range list {
+		if list[i].Name == name {
+			return append(list[:i], list[i+1:]...)
+		}
+	}
+	return list
+}
+
+func upsertPropagatedClusterStatus(list []examplev1alpha1.PropagatedClusterStatus, status examplev1alpha1.PropagatedClusterStatus) []examplev1alpha1.PropagatedClusterStatus {
+	for i := range list {
+		if list[i].ClusterName == status.ClusterName {
+			list[i] = status
+			return list
+		}
+	}
+	return append(list, status)
+}
+
+func removePropagatedClusterStatus(list []examplev1alpha1.PropagatedClusterStatus, clusterName string) []examplev1alpha1.PropagatedClusterStatus {
+	for i := range list {
+		if list[i].ClusterName == clusterName {
+			return append(list[:i], list[i+1:]...)
+		}
+	}
+	return list
+}
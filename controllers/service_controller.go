@@ -0,0 +1,86 @@
+package controllers
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	examplev1alpha1 "github.com/example/k8s-operator/api/v1alpha1"
+)
+
+// ServiceStatusReconciler watches Services labelled app=<AppService.Name> and
+// keeps AppServiceStatus.ResourceBundle.Services in sync.
+type ServiceStatusReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+}
+
+func (r *ServiceStatusReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	service := &corev1.Service{}
+	if err := r.Get(ctx, req.NamespacedName, service); err != nil {
+		if errors.IsNotFound(err) {
+			return ctrl.Result{}, r.removeFromResourceBundle(ctx, req.NamespacedName)
+		}
+		return ctrl.Result{}, err
+	}
+
+	appService, err := findOwningAppService(ctx, r.Client, service)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if appService == nil {
+		return ctrl.Result{}, nil
+	}
+
+	patch := client.MergeFrom(appService.DeepCopy())
+	appService.Status.ResourceBundle.Services = upsertServiceStatus(
+		appService.Status.ResourceBundle.Services,
+		examplev1alpha1.ServiceStatus{
+			Name:      service.Name,
+			Type:      string(service.Spec.Type),
+			ClusterIP: service.Spec.ClusterIP,
+		},
+	)
+
+	if err := r.Status().Patch(ctx, appService, patch); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	logger.V(1).Info("Updated resource bundle", "Service", service.Name, "AppService", appService.Name)
+	return ctrl.Result{}, nil
+}
+
+// removeFromResourceBundle drops key.Name's entry from whichever AppService
+// in key.Namespace still lists it, since the deleted Service no longer
+// carries the "app" label findOwningAppService needs to find it directly.
+func (r *ServiceStatusReconciler) removeFromResourceBundle(ctx context.Context, key client.ObjectKey) error {
+	appService, err := findAppServiceOwningBundleEntry(ctx, r.Client, key.Namespace, func(app examplev1alpha1.AppService) bool {
+		for _, s := range app.Status.ResourceBundle.Services {
+			if s.Name == key.Name {
+				return true
+			}
+		}
+		return false
+	})
+	if err != nil || appService == nil {
+		return err
+	}
+
+	patch := client.MergeFrom(appService.DeepCopy())
+	appService.Status.ResourceBundle.Services = removeServiceStatus(appService.Status.ResourceBundle.Services, key.Name)
+	return r.Status().Patch(ctx, appService, patch)
+}
+
+func (r *ServiceStatusReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&corev1.Service{}).
+		WithEventFilter(HasAppLabel()).
+		Complete(r)
+}
@@ -0,0 +1,116 @@
+package controllers
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	examplev1alpha1 "github.com/example/k8s-operator/api/v1alpha1"
+)
+
+// WorkStatusReconciler aggregates the Applied condition of every Work
+// object belonging to an AppService into AppService.Status.PropagatedClusters,
+// the reverse direction of AppServicePropagationReconciler.
+type WorkStatusReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+}
+
+func (r *WorkStatusReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	work := &examplev1alpha1.Work{}
+	if err := r.Get(ctx, req.NamespacedName, work); err != nil {
+		if errors.IsNotFound(err) {
+			return ctrl.Result{}, r.removeFromPropagatedClusters(ctx, req.NamespacedName)
+		}
+		return ctrl.Result{}, err
+	}
+
+	appService, err := findOwningAppService(ctx, r.Client, work)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if appService == nil {
+		return ctrl.Result{}, nil
+	}
+
+	clusterName, ok := work.GetLabels()[clusterLabelKey]
+	if !ok || clusterName == "" {
+		return ctrl.Result{}, nil
+	}
+
+	patch := client.MergeFrom(appService.DeepCopy())
+	appService.Status.PropagatedClusters = upsertPropagatedClusterStatus(
+		appService.Status.PropagatedClusters,
+		examplev1alpha1.PropagatedClusterStatus{
+			ClusterName: clusterName,
+			Applied:     workAppliedCondition(work),
+			Message:     workAppliedMessage(work),
+		},
+	)
+
+	if err := r.Status().Patch(ctx, appService, patch); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	logger.V(1).Info("Updated propagated cluster status", "Work", work.Name, "cluster", clusterName, "AppService", appService.Name)
+	return ctrl.Result{}, nil
+}
+
+func workAppliedCondition(work *examplev1alpha1.Work) bool {
+	for _, c := range work.Status.Conditions {
+		if c.Type == "Applied" {
+			return c.Status == metav1.ConditionTrue
+		}
+	}
+	return false
+}
+
+func workAppliedMessage(work *examplev1alpha1.Work) string {
+	for _, c := range work.Status.Conditions {
+		if c.Type == "Applied" {
+			return c.Message
+		}
+	}
+	return ""
+}
+
+// removeFromPropagatedClusters drops key's propagated-cluster entry from
+// whichever AppService in key.Namespace still lists it, since the deleted
+// Work no longer carries the "cluster" label this reconciler would
+// otherwise read to find it directly. It identifies the entry by
+// recomputing workName for each AppService/cluster pair, since
+// PropagatedClusterStatus itself doesn't record the Work's name.
+func (r *WorkStatusReconciler) removeFromPropagatedClusters(ctx context.Context, key client.ObjectKey) error {
+	appServices := &examplev1alpha1.AppServiceList{}
+	if err := r.List(ctx, appServices, client.InNamespace(key.Namespace)); err != nil {
+		return err
+	}
+
+	for i := range appServices.Items {
+		appService := &appServices.Items[i]
+		for _, status := range appService.Status.PropagatedClusters {
+			if workName(appService.Name, status.ClusterName) != key.Name {
+				continue
+			}
+
+			patch := client.MergeFrom(appService.DeepCopy())
+			appService.Status.PropagatedClusters = removePropagatedClusterStatus(appService.Status.PropagatedClusters, status.ClusterName)
+			return r.Status().Patch(ctx, appService, patch)
+		}
+	}
+	return nil
+}
+
+func (r *WorkStatusReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&examplev1alpha1.Work{}).
+		WithEventFilter(HasAppLabel()).
+		Complete(r)
+}
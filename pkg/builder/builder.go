@@ -0,0 +1,131 @@
+// Package builder is a thin wrapper around controller-runtime's
+// ctrl.NewControllerManagedBy that adds first-class support for registering
+// For/Owns targets as metadata-only watches, so operators in this module
+// don't have to reach for controller-runtime's lower-level builder.OnlyMetadata
+// option directly.
+package builder
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	crbuilder "sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// objectProjection tracks whether a For/Owns target should be watched in its
+// normal typed form or projected down to metadata-only.
+type objectProjection int
+
+const (
+	projectAsNormal objectProjection = iota
+	projectAsMetadata
+)
+
+// ForOption configures a For() call.
+type ForOption interface {
+	applyToFor(*forConfig)
+}
+
+// OwnsOption configures an Owns() call.
+type OwnsOption interface {
+	applyToOwns(*ownsConfig)
+}
+
+type forConfig struct{ projection objectProjection }
+type ownsConfig struct{ projection objectProjection }
+
+type projectAs objectProjection
+
+func (p projectAs) applyToFor(c *forConfig)   { c.projection = objectProjection(p) }
+func (p projectAs) applyToOwns(c *ownsConfig) { c.projection = objectProjection(p) }
+
+// OnlyMetadata tells the Builder to watch and cache the target as
+// metav1.PartialObjectMetadata instead of its typed form. Use it for
+// high-cardinality owned types (Pods, ConfigMaps, ...) where the reconciler
+// only needs to react to changes, not read spec/status.
+//
+// As with controller-runtime's own builder.OnlyMetadata, callers must Get/List
+// these objects through a *metav1.PartialObjectMetadata, not the typed form,
+// or they'll end up paying for two caches instead of one.
+var OnlyMetadata = projectAs(projectAsMetadata)
+
+// project swaps obj for a metav1.PartialObjectMetadata carrying the same GVK
+// when proj asks for a metadata-only watch, and returns obj unchanged
+// otherwise.
+func project(scheme *runtime.Scheme, obj client.Object, proj objectProjection) (client.Object, error) {
+	if proj == projectAsNormal {
+		return obj, nil
+	}
+
+	gvk, err := apiutil.GVKForObject(obj, scheme)
+	if err != nil {
+		return nil, fmt.Errorf("unable to determine GVK of %T for a metadata-only watch: %w", obj, err)
+	}
+
+	meta := &metav1.PartialObjectMetadata{}
+	meta.SetGroupVersionKind(gvk)
+	return meta, nil
+}
+
+// Builder builds a controller-runtime Controller, the same way
+// ctrl.NewControllerManagedBy does, but lets For/Owns targets opt into
+// OnlyMetadata projection.
+type Builder struct {
+	mgr   manager.Manager
+	blder *crbuilder.Builder
+	err   error
+}
+
+// ControllerManagedBy returns a new Builder that will be started by mgr.
+func ControllerManagedBy(mgr manager.Manager) *Builder {
+	return &Builder{mgr: mgr, blder: ctrl.NewControllerManagedBy(mgr)}
+}
+
+// For defines the type of Object being reconciled.
+func (b *Builder) For(obj client.Object, opts ...ForOption) *Builder {
+	cfg := &forConfig{}
+	for _, opt := range opts {
+		opt.applyToFor(cfg)
+	}
+
+	projected, err := project(b.mgr.GetScheme(), obj, cfg.projection)
+	if err != nil {
+		b.err = err
+		return b
+	}
+
+	b.blder = b.blder.For(projected)
+	return b
+}
+
+// Owns defines a type of Object generated by the controller, projecting it to
+// metadata-only when OnlyMetadata is passed.
+func (b *Builder) Owns(obj client.Object, opts ...OwnsOption) *Builder {
+	cfg := &ownsConfig{}
+	for _, opt := range opts {
+		opt.applyToOwns(cfg)
+	}
+
+	projected, err := project(b.mgr.GetScheme(), obj, cfg.projection)
+	if err != nil {
+		b.err = err
+		return b
+	}
+
+	b.blder = b.blder.Owns(projected)
+	return b
+}
+
+// Complete builds the controller.
+func (b *Builder) Complete(r reconcile.Reconciler) error {
+	if b.err != nil {
+		return b.err
+	}
+	return b.blder.Complete(r)
+}
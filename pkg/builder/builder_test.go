@@ -0,0 +1,107 @@
+package builder
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	clientfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/scheme"
+)
+
+func testScheme(t *testing.T) *runtime.Scheme {
+	t.Helper()
+	s := runtime.NewScheme()
+	b := (&scheme.Builder{GroupVersion: appsv1.SchemeGroupVersion}).Register(&appsv1.Deployment{}, &appsv1.DeploymentList{})
+	if err := b.AddToScheme(s); err != nil {
+		t.Fatalf("failed to build scheme: %v", err)
+	}
+	return s
+}
+
+func TestProjectAsNormalReturnsObjectUnchanged(t *testing.T) {
+	dep := &appsv1.Deployment{}
+
+	got, err := project(testScheme(t), dep, projectAsNormal)
+	if err != nil {
+		t.Fatalf("project returned error: %v", err)
+	}
+	if got != client.Object(dep) {
+		t.Fatalf("expected the original object back, got %T", got)
+	}
+}
+
+func TestProjectAsMetadataSwapsInPartialObjectMetadata(t *testing.T) {
+	dep := &appsv1.Deployment{}
+
+	got, err := project(testScheme(t), dep, projectAsMetadata)
+	if err != nil {
+		t.Fatalf("project returned error: %v", err)
+	}
+
+	meta, ok := got.(*metav1.PartialObjectMetadata)
+	if !ok {
+		t.Fatalf("expected *metav1.PartialObjectMetadata, got %T", got)
+	}
+
+	want := appsv1.SchemeGroupVersion.WithKind("Deployment")
+	if got := meta.GroupVersionKind(); got != want {
+		t.Fatalf("expected GVK %v, got %v", want, got)
+	}
+}
+
+// TestOnlyMetadataProjectionReceivesWatchEventsForTheGVK proves project()'s
+// metadata-only GVK is the one the underlying watch actually fires events
+// for, not just that the Go type swap happens - the behavior Owns(obj,
+// OnlyMetadata) relies on to let controllers react to an owned type without
+// a typed cache. There's no envtest in this environment to drive a real
+// Builder/manager end-to-end, so this drives the same project()+GVK-derived
+// watch the manager's cache would set up, against the fake client's real
+// tracker-backed Watch implementation.
+func TestOnlyMetadataProjectionReceivesWatchEventsForTheGVK(t *testing.T) {
+	s := testScheme(t)
+
+	projected, err := project(s, &appsv1.Deployment{}, projectAsMetadata)
+	if err != nil {
+		t.Fatalf("project returned error: %v", err)
+	}
+	gvk := projected.GetObjectKind().GroupVersionKind()
+
+	c := clientfake.NewClientBuilder().WithScheme(s).Build()
+
+	list := &metav1.PartialObjectMetadataList{}
+	list.SetGroupVersionKind(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+
+	watcher, err := c.Watch(context.Background(), list, client.InNamespace("default"))
+	if err != nil {
+		t.Fatalf("Watch returned error: %v", err)
+	}
+	defer watcher.Stop()
+
+	dep := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"}}
+	if err := c.Create(context.Background(), dep); err != nil {
+		t.Fatalf("Create returned error: %v", err)
+	}
+
+	select {
+	case event := <-watcher.ResultChan():
+		if event.Type != watch.Added {
+			t.Fatalf("expected an Added event, got %s", event.Type)
+		}
+		got, err := meta.Accessor(event.Object)
+		if err != nil {
+			t.Fatalf("failed to access event object: %v", err)
+		}
+		if got.GetName() != "web" {
+			t.Fatalf("expected the Deployment named 'web', got %q", got.GetName())
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for a watch event on the metadata-only GVK")
+	}
+}
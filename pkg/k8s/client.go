@@ -2,6 +2,7 @@ package k8s
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 
 	appsv1 "k8s.io/api/apps/v1"
@@ -15,6 +16,13 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+// propagationFieldManager identifies this client to the API server for
+// server-side apply when pushing a propagated AppService's manifests onto
+// a member cluster, the same reasoning BaseReconciler.Apply documents for
+// the hub cluster: omitting a field a later manager owns (e.g. an HPA's
+// claim on Spec.Replicas) leaves it alone instead of reasserting it.
+const propagationFieldManager = "appservice-propagation-controller"
+
 type Client struct {
 	clientset *kubernetes.Clientset
 	config    *rest.Config
@@ -34,6 +42,12 @@ func NewClient(kubeconfig string) (*Client, error) {
 		return nil, fmt.Errorf("failed to create config: %w", err)
 	}
 
+	return NewClientFromConfig(config)
+}
+
+// NewClientFromConfig builds a Client from an already-resolved rest.Config,
+// e.g. one parsed from a cluster's kubeconfig Secret by ClusterClientCache.
+func NewClientFromConfig(config *rest.Config) (*Client, error) {
 	clientset, err := kubernetes.NewForConfig(config)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create clientset: %w", err)
@@ -69,6 +83,40 @@ func (c *Client) CreateService(ctx context.Context, service *corev1.Service) err
 	return err
 }
 
+// ApplyDeployment server-side-applies deployment to the member cluster,
+// creating it if it doesn't exist yet. See propagationFieldManager for why
+// SSA is used instead of a blind create-or-update.
+func (c *Client) ApplyDeployment(ctx context.Context, deployment *appsv1.Deployment) error {
+	data, err := json.Marshal(deployment)
+	if err != nil {
+		return fmt.Errorf("failed to encode Deployment %q: %w", deployment.Name, err)
+	}
+
+	force := true
+	_, err = c.clientset.AppsV1().Deployments(deployment.Namespace).Patch(
+		ctx, deployment.Name, types.ApplyPatchType, data,
+		metav1.PatchOptions{FieldManager: propagationFieldManager, Force: &force},
+	)
+	return err
+}
+
+// ApplyService server-side-applies service to the member cluster, creating
+// it if it doesn't exist yet. See propagationFieldManager for why SSA is
+// used instead of a blind create-or-update.
+func (c *Client) ApplyService(ctx context.Context, service *corev1.Service) error {
+	data, err := json.Marshal(service)
+	if err != nil {
+		return fmt.Errorf("failed to encode Service %q: %w", service.Name, err)
+	}
+
+	force := true
+	_, err = c.clientset.CoreV1().Services(service.Namespace).Patch(
+		ctx, service.Name, types.ApplyPatchType, data,
+		metav1.PatchOptions{FieldManager: propagationFieldManager, Force: &force},
+	)
+	return err
+}
+
 func (c *Client) ListPods(ctx context.Context, namespace string, labelSelector string) (*corev1.PodList, error) {
 	return c.clientset.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{LabelSelector: labelSelector})
 }
@@ -0,0 +1,103 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/clientcmd"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	examplev1alpha1 "github.com/example/k8s-operator/api/v1alpha1"
+)
+
+const defaultKubeconfigSecretKey = "kubeconfig"
+
+// ClusterClientCache lazily builds and caches a Client per member cluster,
+// keyed by the namespace/name of its (namespace-scoped) Cluster object. The
+// kubeconfig for each cluster comes from the Secret named in
+// Cluster.Spec.KubeconfigSecretRef, so building a Client means reading the
+// Cluster and its Secret off the hub cluster (hub) before dialing the
+// member cluster itself.
+type ClusterClientCache struct {
+	hub client.Client
+
+	mu        sync.Mutex
+	byNameKey map[string]*Client
+}
+
+// NewClusterClientCache builds a ClusterClientCache that resolves Cluster
+// and Secret objects via hub.
+func NewClusterClientCache(hub client.Client) *ClusterClientCache {
+	return &ClusterClientCache{
+		hub:       hub,
+		byNameKey: make(map[string]*Client),
+	}
+}
+
+// Get returns the Client for the Cluster named clusterName in namespace,
+// building and caching it on first use.
+func (c *ClusterClientCache) Get(ctx context.Context, namespace, clusterName string) (*Client, error) {
+	nameKey := namespace + "/" + clusterName
+
+	c.mu.Lock()
+	cached, ok := c.byNameKey[nameKey]
+	c.mu.Unlock()
+	if ok {
+		return cached, nil
+	}
+
+	built, err := c.buildClient(ctx, namespace, clusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	c.byNameKey[nameKey] = built
+	c.mu.Unlock()
+	return built, nil
+}
+
+// Forget evicts the cached Client for the Cluster named clusterName in
+// namespace, e.g. after it starts failing requests with credentials that
+// might have been rotated.
+func (c *ClusterClientCache) Forget(namespace, clusterName string) {
+	c.mu.Lock()
+	delete(c.byNameKey, namespace+"/"+clusterName)
+	c.mu.Unlock()
+}
+
+func (c *ClusterClientCache) buildClient(ctx context.Context, namespace, clusterName string) (*Client, error) {
+	cluster := &examplev1alpha1.Cluster{}
+	if err := c.hub.Get(ctx, client.ObjectKey{Name: clusterName, Namespace: namespace}, cluster); err != nil {
+		return nil, fmt.Errorf("failed to get Cluster %s/%s: %w", namespace, clusterName, err)
+	}
+
+	ref := cluster.Spec.KubeconfigSecretRef
+	secretNamespace := ref.Namespace
+	if secretNamespace == "" {
+		secretNamespace = cluster.Namespace
+	}
+
+	secret := &corev1.Secret{}
+	if err := c.hub.Get(ctx, client.ObjectKey{Name: ref.Name, Namespace: secretNamespace}, secret); err != nil {
+		return nil, fmt.Errorf("failed to get kubeconfig secret %s/%s for cluster %q: %w", secretNamespace, ref.Name, clusterName, err)
+	}
+
+	key := ref.Key
+	if key == "" {
+		key = defaultKubeconfigSecretKey
+	}
+	kubeconfig, ok := secret.Data[key]
+	if !ok {
+		return nil, fmt.Errorf("secret %s/%s has no key %q", secretNamespace, ref.Name, key)
+	}
+
+	config, err := clientcmd.RESTConfigFromKubeConfig(kubeconfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse kubeconfig for cluster %q: %w", clusterName, err)
+	}
+
+	return NewClientFromConfig(config)
+}
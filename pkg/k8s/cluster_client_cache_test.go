@@ -0,0 +1,150 @@
+package k8s
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	clientfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	examplev1alpha1 "github.com/example/k8s-operator/api/v1alpha1"
+)
+
+// fakeKubeconfig is a minimal, valid kubeconfig. Its server never needs to
+// be reachable - buildClient only has to parse it and hand it to
+// kubernetes.NewForConfig, which doesn't dial anything itself.
+const fakeKubeconfig = `
+apiVersion: v1
+kind: Config
+clusters:
+- name: member
+  cluster:
+    server: https://member.example.com
+contexts:
+- name: member
+  context:
+    cluster: member
+    user: member
+current-context: member
+users:
+- name: member
+  user:
+    token: fake-token
+`
+
+func testHubClient(t *testing.T, initObjs ...client.Object) client.Client {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	if err := corev1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to register corev1: %v", err)
+	}
+	if err := examplev1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to register v1alpha1: %v", err)
+	}
+	return clientfake.NewClientBuilder().WithScheme(scheme).WithObjects(initObjs...).Build()
+}
+
+func TestClusterClientCacheDefaultsSecretNamespaceAndKey(t *testing.T) {
+	cluster := &examplev1alpha1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "member", Namespace: "team-a"},
+		Spec: examplev1alpha1.ClusterSpec{
+			KubeconfigSecretRef: examplev1alpha1.SecretReference{Name: "member-kubeconfig"},
+		},
+	}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "member-kubeconfig", Namespace: "team-a"},
+		Data:       map[string][]byte{"kubeconfig": []byte(fakeKubeconfig)},
+	}
+
+	cache := NewClusterClientCache(testHubClient(t, cluster, secret))
+
+	if _, err := cache.Get(context.Background(), "team-a", "member"); err != nil {
+		t.Fatalf("Get returned error: %v", err)
+	}
+}
+
+func TestClusterClientCacheHonoursExplicitSecretNamespaceAndKey(t *testing.T) {
+	cluster := &examplev1alpha1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "member", Namespace: "team-a"},
+		Spec: examplev1alpha1.ClusterSpec{
+			KubeconfigSecretRef: examplev1alpha1.SecretReference{
+				Name:      "member-kubeconfig",
+				Namespace: "kube-system",
+				Key:       "kubeconfig.yaml",
+			},
+		},
+	}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "member-kubeconfig", Namespace: "kube-system"},
+		Data:       map[string][]byte{"kubeconfig.yaml": []byte(fakeKubeconfig)},
+	}
+
+	cache := NewClusterClientCache(testHubClient(t, cluster, secret))
+
+	if _, err := cache.Get(context.Background(), "team-a", "member"); err != nil {
+		t.Fatalf("Get returned error: %v", err)
+	}
+}
+
+func TestClusterClientCacheErrorsOnMissingSecretKey(t *testing.T) {
+	cluster := &examplev1alpha1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "member", Namespace: "team-a"},
+		Spec: examplev1alpha1.ClusterSpec{
+			KubeconfigSecretRef: examplev1alpha1.SecretReference{Name: "member-kubeconfig"},
+		},
+	}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "member-kubeconfig", Namespace: "team-a"},
+		Data:       map[string][]byte{"wrong-key": []byte(fakeKubeconfig)},
+	}
+
+	cache := NewClusterClientCache(testHubClient(t, cluster, secret))
+
+	_, err := cache.Get(context.Background(), "team-a", "member")
+	if err == nil {
+		t.Fatal("expected an error, got nil")
+	}
+	if !strings.Contains(err.Error(), "kubeconfig") {
+		t.Fatalf("expected error to mention the missing key, got: %v", err)
+	}
+}
+
+func TestClusterClientCacheCachesByNamespaceAndName(t *testing.T) {
+	cluster := &examplev1alpha1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "member", Namespace: "team-a"},
+		Spec: examplev1alpha1.ClusterSpec{
+			KubeconfigSecretRef: examplev1alpha1.SecretReference{Name: "member-kubeconfig"},
+		},
+	}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "member-kubeconfig", Namespace: "team-a"},
+		Data:       map[string][]byte{"kubeconfig": []byte(fakeKubeconfig)},
+	}
+
+	cache := NewClusterClientCache(testHubClient(t, cluster, secret))
+
+	first, err := cache.Get(context.Background(), "team-a", "member")
+	if err != nil {
+		t.Fatalf("Get returned error: %v", err)
+	}
+	second, err := cache.Get(context.Background(), "team-a", "member")
+	if err != nil {
+		t.Fatalf("Get returned error: %v", err)
+	}
+	if first != second {
+		t.Fatal("expected the second Get to return the cached Client")
+	}
+
+	cache.Forget("team-a", "member")
+	third, err := cache.Get(context.Background(), "team-a", "member")
+	if err != nil {
+		t.Fatalf("Get returned error: %v", err)
+	}
+	if third == first {
+		t.Fatal("expected Forget to evict the cached Client")
+	}
+}
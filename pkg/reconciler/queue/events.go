@@ -0,0 +1,29 @@
+package queue
+
+import (
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/example/k8s-operator/pkg/watcher"
+)
+
+// EventHandler adapts a Queue to watcher.EventHandler, so a ResourceWatcher's
+// Add/Update/Delete callbacks enqueue reconciles directly without a separate
+// translation layer.
+type EventHandler struct {
+	Queue *Queue
+}
+
+var _ watcher.EventHandler = (*EventHandler)(nil)
+
+func (h *EventHandler) OnAdd(obj interface{})          { h.enqueue(obj) }
+func (h *EventHandler) OnUpdate(_, newObj interface{}) { h.enqueue(newObj) }
+func (h *EventHandler) OnDelete(obj interface{})       { h.enqueue(obj) }
+
+func (h *EventHandler) enqueue(obj interface{}) {
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return
+	}
+	h.Queue.Add(Key{NamespacedName: types.NamespacedName{Name: accessor.GetName(), Namespace: accessor.GetNamespace()}})
+}
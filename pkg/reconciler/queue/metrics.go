@@ -0,0 +1,33 @@
+package queue
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+var (
+	depthGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "reconciler_queue_depth",
+		Help: "Current number of items waiting in a reconciler work queue.",
+	}, []string{"queue"})
+
+	addsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "reconciler_queue_adds_total",
+		Help: "Total number of items added to a reconciler work queue.",
+	}, []string{"queue"})
+
+	retriesTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "reconciler_queue_retries_total",
+		Help: "Total number of reconciles requeued with backoff after returning an error.",
+	}, []string{"queue"})
+
+	latencySeconds = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "reconciler_queue_reconcile_duration_seconds",
+		Help:    "Per-key reconcile latency for a reconciler work queue.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"queue"})
+)
+
+func init() {
+	metrics.Registry.MustRegister(depthGauge, addsTotal, retriesTotal, latencySeconds)
+}
@@ -0,0 +1,147 @@
+// Package queue provides a rate-limited, per-key-deduplicating workqueue for
+// reconcilers, along with a WorkQueueReconciler that drains it. It gives
+// reconcilers built on it the same backoff/dedup semantics upstream
+// controllers get from controller-runtime's internal queue, without having
+// to hand-roll it per project.
+package queue
+
+import (
+	"context"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/example/k8s-operator/pkg/reconciler"
+)
+
+// Key identifies one object to reconcile. It's a type alias for ctrl.Request
+// so a Queue interops directly with reconciler.Reconciler implementations.
+type Key = ctrl.Request
+
+// Queue is a workqueue.RateLimitingInterface that also reports its
+// depth/adds/retries as Prometheus metrics, labelled by name.
+type Queue struct {
+	workqueue.RateLimitingInterface
+	name string
+}
+
+// New builds a Queue backed by workqueue.DefaultControllerRateLimiter(),
+// registering its metrics under name.
+func New(name string) *Queue {
+	return &Queue{
+		RateLimitingInterface: workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), name),
+		name:                  name,
+	}
+}
+
+func (q *Queue) Add(item interface{}) {
+	q.RateLimitingInterface.Add(item)
+	addsTotal.WithLabelValues(q.name).Inc()
+	depthGauge.WithLabelValues(q.name).Set(float64(q.Len()))
+}
+
+func (q *Queue) AddAfter(item interface{}, duration time.Duration) {
+	q.RateLimitingInterface.AddAfter(item, duration)
+	addsTotal.WithLabelValues(q.name).Inc()
+}
+
+func (q *Queue) AddRateLimited(item interface{}) {
+	q.RateLimitingInterface.AddRateLimited(item)
+	retriesTotal.WithLabelValues(q.name).Inc()
+	depthGauge.WithLabelValues(q.name).Set(float64(q.Len()))
+}
+
+func (q *Queue) Done(item interface{}) {
+	q.RateLimitingInterface.Done(item)
+	depthGauge.WithLabelValues(q.name).Set(float64(q.Len()))
+}
+
+// EnqueueAfter adds key to q after duration, e.g. for implementing a
+// reconciler's own RequeueAfter semantics without returning through
+// ctrl.Result.
+func (q *Queue) EnqueueAfter(key Key, duration time.Duration) {
+	q.AddAfter(key, duration)
+}
+
+// EnqueueOwner adds the key of obj's controlling owner to q, if it has one of
+// kind ownerKind.
+func EnqueueOwner(q *Queue, obj metav1.Object, ownerKind string) {
+	for _, ref := range obj.GetOwnerReferences() {
+		if ref.Controller != nil && *ref.Controller && ref.Kind == ownerKind {
+			q.Add(Key{NamespacedName: types.NamespacedName{Name: ref.Name, Namespace: obj.GetNamespace()}})
+			return
+		}
+	}
+}
+
+// EnqueueLabelMapped adds the key named by obj's labelKey label, in the same
+// namespace as obj, to q. It's the Queue equivalent of the label-based
+// ownership lookup the AppService resource-bundle controllers use (see
+// controllers.findOwningAppService).
+func EnqueueLabelMapped(q *Queue, obj metav1.Object, labelKey string) {
+	name, ok := obj.GetLabels()[labelKey]
+	if !ok || name == "" {
+		return
+	}
+	q.Add(Key{NamespacedName: types.NamespacedName{Name: name, Namespace: obj.GetNamespace()}})
+}
+
+// WorkQueueReconciler drains Queue, invoking Reconciler.Reconcile for each
+// key. A returned error requeues the key with backoff via AddRateLimited
+// instead of retrying immediately; success Forgets it, resetting its
+// backoff.
+type WorkQueueReconciler struct {
+	Queue      *Queue
+	Reconciler reconciler.Reconciler
+	Workers    int
+}
+
+// Run starts Workers worker goroutines (default 1) draining Queue until ctx
+// is done, then shuts the queue down. Run blocks until ctx is done.
+func (r *WorkQueueReconciler) Run(ctx context.Context) {
+	workers := r.Workers
+	if workers <= 0 {
+		workers = 1
+	}
+
+	for i := 0; i < workers; i++ {
+		go r.runWorker(ctx)
+	}
+
+	<-ctx.Done()
+	r.Queue.ShutDown()
+}
+
+func (r *WorkQueueReconciler) runWorker(ctx context.Context) {
+	for r.processNextItem(ctx) {
+	}
+}
+
+func (r *WorkQueueReconciler) processNextItem(ctx context.Context) bool {
+	item, shutdown := r.Queue.Get()
+	if shutdown {
+		return false
+	}
+	defer r.Queue.Done(item)
+
+	key, ok := item.(Key)
+	if !ok {
+		r.Queue.Forget(item)
+		return true
+	}
+
+	start := time.Now()
+	_, err := r.Reconciler.Reconcile(ctx, key)
+	latencySeconds.WithLabelValues(r.Queue.name).Observe(time.Since(start).Seconds())
+
+	if err != nil {
+		r.Queue.AddRateLimited(item)
+		return true
+	}
+
+	r.Queue.Forget(item)
+	return true
+}
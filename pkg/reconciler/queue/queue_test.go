@@ -0,0 +1,84 @@
+package queue
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+func nsn(name string) types.NamespacedName {
+	return types.NamespacedName{Name: name}
+}
+
+type stubReconciler struct {
+	calls   []ctrl.Request
+	failFor map[string]bool
+}
+
+func (s *stubReconciler) Reconcile(_ context.Context, req ctrl.Request) (ctrl.Result, error) {
+	s.calls = append(s.calls, req)
+	if s.failFor[req.Name] {
+		s.failFor[req.Name] = false
+		return ctrl.Result{}, errors.New("boom")
+	}
+	return ctrl.Result{}, nil
+}
+
+func TestWorkQueueReconcilerRetriesOnError(t *testing.T) {
+	q := New("test")
+	stub := &stubReconciler{failFor: map[string]bool{"retry-me": true}}
+	wqr := &WorkQueueReconciler{Queue: q, Reconciler: stub}
+
+	q.Add(Key{NamespacedName: nsn("retry-me")})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	for len(stub.calls) < 2 {
+		if !wqr.processNextItem(ctx) {
+			t.Fatal("queue shut down before the retry happened")
+		}
+	}
+
+	if stub.calls[0].Name != "retry-me" || stub.calls[1].Name != "retry-me" {
+		t.Fatalf("expected two reconciles of retry-me, got %v", stub.calls)
+	}
+}
+
+func TestEnqueueLabelMappedAddsMappedKey(t *testing.T) {
+	q := New("test")
+	obj := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{
+		Namespace: "default",
+		Labels:    map[string]string{"app": "my-appservice"},
+	}}
+
+	EnqueueLabelMapped(q, obj, "app")
+
+	item, _ := q.Get()
+	defer q.Done(item)
+
+	key, ok := item.(Key)
+	if !ok {
+		t.Fatalf("expected a Key, got %T", item)
+	}
+	if key.Name != "my-appservice" || key.Namespace != "default" {
+		t.Fatalf("unexpected key: %+v", key)
+	}
+}
+
+func TestEnqueueLabelMappedSkipsObjectsWithoutTheLabel(t *testing.T) {
+	q := New("test")
+	obj := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: "default"}}
+
+	EnqueueLabelMapped(q, obj, "app")
+
+	if q.Len() != 0 {
+		t.Fatalf("expected nothing enqueued, queue has %d items", q.Len())
+	}
+}
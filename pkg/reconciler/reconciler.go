@@ -6,7 +6,12 @@ import (
 	"time"
 
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	metadataclient "k8s.io/client-go/metadata"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log"
@@ -19,6 +24,27 @@ type Reconciler interface {
 type BaseReconciler struct {
 	Client client.Client
 	Scheme *runtime.Scheme
+
+	// MetadataClient and RESTMapper, when both set, let GetObject/DeleteIfExists
+	// serve *metav1.PartialObjectMetadata objects without going through a typed
+	// cache, mirroring the cache savings builder.OnlyMetadata gets controllers.
+	MetadataClient metadataclient.Interface
+	RESTMapper     meta.RESTMapper
+}
+
+// metadataResource resolves the GroupVersionResource for obj's GVK via
+// RESTMapper, for use against MetadataClient.
+func (r *BaseReconciler) metadataResource(obj *metav1.PartialObjectMetadata) (metadataclient.ResourceInterface, error) {
+	gvk := obj.GroupVersionKind()
+	mapping, err := r.RESTMapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	if err != nil {
+		return nil, fmt.Errorf("failed to map GVK %s to a resource: %w", gvk, err)
+	}
+	res := r.MetadataClient.Resource(mapping.Resource)
+	if obj.GetNamespace() == "" {
+		return res, nil
+	}
+	return res.Namespace(obj.GetNamespace()), nil
 }
 
 func (r *BaseReconciler) HandleError(ctx context.Context, err error, msg string) (ctrl.Result, error) {
@@ -43,6 +69,22 @@ func (r *BaseReconciler) Done() ctrl.Result {
 }
 
 func (r *BaseReconciler) GetObject(ctx context.Context, key client.ObjectKey, obj client.Object) error {
+	if pmeta, ok := obj.(*metav1.PartialObjectMetadata); ok && r.MetadataClient != nil {
+		res, err := r.metadataResource(pmeta)
+		if err != nil {
+			return err
+		}
+		got, err := res.Get(ctx, key.Name, metav1.GetOptions{})
+		if err != nil {
+			if errors.IsNotFound(err) {
+				return nil
+			}
+			return err
+		}
+		*pmeta = *got
+		return nil
+	}
+
 	if err := r.Client.Get(ctx, key, obj); err != nil {
 		if errors.IsNotFound(err) {
 			return nil
@@ -52,23 +94,106 @@ func (r *BaseReconciler) GetObject(ctx context.Context, key client.ObjectKey, ob
 	return nil
 }
 
-func (r *BaseReconciler) CreateOrUpdate(ctx context.Context, obj client.Object) error {
+// OperationResult reports what CreateOrPatch did to reconcile obj with the
+// cluster's state.
+type OperationResult string
+
+const (
+	// OperationResultNone means obj already matched the cluster's state; no
+	// create or patch request was sent.
+	OperationResultNone OperationResult = "unchanged"
+	// OperationResultCreated means obj didn't exist and was created.
+	OperationResultCreated OperationResult = "created"
+	// OperationResultUpdated means obj existed and was patched.
+	OperationResultUpdated OperationResult = "updated"
+)
+
+// CreateOrPatch fetches the object identified by obj's key into obj, calls
+// mutate to reconcile the desired state onto it, and patches back only the
+// resulting diff. Unlike a blind Update, fields mutate never touches are
+// simply absent from the patch, so it doesn't fight other controllers or
+// defaulters that own those fields - e.g. an HPA adjusting a Deployment's
+// Spec.Replicas. mutate can tell creation from update by checking
+// obj.GetResourceVersion() == "".
+//
+// Typed objects are diffed with a strategic merge patch, which understands
+// list-merge keys (e.g. container name) for fields like PodSpec.Containers.
+// *unstructured.Unstructured objects - the shape a CRD takes when no Go type
+// is registered for it - fall back to a JSON merge patch, since strategic
+// merge patch needs struct field tags unstructured data doesn't have.
+func (r *BaseReconciler) CreateOrPatch(ctx context.Context, obj client.Object, mutate func() error) (OperationResult, error) {
 	key := client.ObjectKeyFromObject(obj)
-	existing := obj.DeepCopyObject().(client.Object)
+	if err := r.Client.Get(ctx, key, obj); err != nil {
+		if !errors.IsNotFound(err) {
+			return OperationResultNone, err
+		}
+		if mutate != nil {
+			if err := mutate(); err != nil {
+				return OperationResultNone, err
+			}
+		}
+		if err := r.Client.Create(ctx, obj); err != nil {
+			return OperationResultNone, err
+		}
+		return OperationResultCreated, nil
+	}
 
-	err := r.Client.Get(ctx, key, existing)
-	if err != nil {
-		if errors.IsNotFound(err) {
-			return r.Client.Create(ctx, obj)
+	patch := threeWayPatchFrom(obj)
+
+	if mutate != nil {
+		if err := mutate(); err != nil {
+			return OperationResultNone, err
 		}
-		return err
 	}
 
-	obj.SetResourceVersion(existing.GetResourceVersion())
-	return r.Client.Update(ctx, obj)
+	data, err := patch.Data(obj)
+	if err != nil {
+		return OperationResultNone, err
+	}
+	if string(data) == "{}" {
+		return OperationResultNone, nil
+	}
+
+	if err := r.Client.Patch(ctx, obj, patch); err != nil {
+		return OperationResultNone, err
+	}
+	return OperationResultUpdated, nil
+}
+
+// threeWayPatchFrom snapshots obj's current state as the patch base, picking
+// a strategic merge patch for typed objects or a JSON merge patch for
+// *unstructured.Unstructured.
+func threeWayPatchFrom(obj client.Object) client.Patch {
+	base := obj.DeepCopyObject().(client.Object)
+	if _, ok := obj.(*unstructured.Unstructured); ok {
+		return client.MergeFrom(base)
+	}
+	return client.StrategicMergeFrom(base)
+}
+
+// Apply reconciles obj via server-side apply instead of a patch computed
+// from a prior Get. fieldManager should identify the calling controller
+// (e.g. "appservice-controller"); the API server tracks field ownership per
+// manager, so fields obj doesn't set are left alone for other managers
+// (such as an HPA owning Deployment.Spec.Replicas) instead of being
+// reclaimed on every reconcile.
+func (r *BaseReconciler) Apply(ctx context.Context, obj client.Object, fieldManager string) error {
+	return r.Client.Patch(ctx, obj, client.Apply, client.FieldOwner(fieldManager), client.ForceOwnership)
 }
 
 func (r *BaseReconciler) DeleteIfExists(ctx context.Context, obj client.Object) error {
+	if pmeta, ok := obj.(*metav1.PartialObjectMetadata); ok && r.MetadataClient != nil {
+		res, err := r.metadataResource(pmeta)
+		if err != nil {
+			return err
+		}
+		err = res.Delete(ctx, pmeta.GetName(), metav1.DeleteOptions{})
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
 	err := r.Client.Delete(ctx, obj)
 	if errors.IsNotFound(err) {
 		return nil
@@ -76,6 +201,43 @@ func (r *BaseReconciler) DeleteIfExists(ctx context.Context, obj client.Object)
 	return err
 }
 
+// PatchMetadataObject applies a JSON patch (e.g. a merge or strategic-merge
+// patch) to the object identified by obj via MetadataClient, without
+// hydrating its typed form. obj is updated in place with the server's
+// response.
+func (r *BaseReconciler) PatchMetadataObject(ctx context.Context, obj *metav1.PartialObjectMetadata, patchType types.PatchType, data []byte) error {
+	if r.MetadataClient == nil {
+		return fmt.Errorf("PatchMetadataObject requires a MetadataClient")
+	}
+
+	res, err := r.metadataResource(obj)
+	if err != nil {
+		return err
+	}
+
+	patched, err := res.Patch(ctx, obj.GetName(), patchType, data, metav1.PatchOptions{})
+	if err != nil {
+		return err
+	}
+	*obj = *patched
+	return nil
+}
+
+// ListMetadataObjects lists obj's resource type via MetadataClient and
+// returns the items as PartialObjectMetadata, without hydrating the typed
+// form.
+func (r *BaseReconciler) ListMetadataObjects(ctx context.Context, obj *metav1.PartialObjectMetadata, opts metav1.ListOptions) (*metav1.PartialObjectMetadataList, error) {
+	if r.MetadataClient == nil {
+		return nil, fmt.Errorf("ListMetadataObjects requires a MetadataClient")
+	}
+
+	res, err := r.metadataResource(obj)
+	if err != nil {
+		return nil, err
+	}
+	return res.List(ctx, opts)
+}
+
 type ReconcileResult struct {
 	Requeue      bool
 	RequeueAfter time.Duration
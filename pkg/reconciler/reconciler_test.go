@@ -0,0 +1,213 @@
+package reconciler
+
+import (
+	"context"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	metadatafake "k8s.io/client-go/metadata/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	clientfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func testClient(t *testing.T, initObjs ...client.Object) client.Client {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	if err := appsv1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to register appsv1: %v", err)
+	}
+	if err := corev1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to register corev1: %v", err)
+	}
+	return clientfake.NewClientBuilder().WithScheme(scheme).WithObjects(initObjs...).Build()
+}
+
+func TestCreateOrPatchCreatesWhenAbsent(t *testing.T) {
+	r := &BaseReconciler{Client: testClient(t)}
+
+	dep := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"}}
+	result, err := r.CreateOrPatch(context.Background(), dep, func() error {
+		dep.Spec.Selector = &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}}
+		dep.Spec.Template = corev1.PodTemplateSpec{ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "web"}}}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("CreateOrPatch returned error: %v", err)
+	}
+	if result != OperationResultCreated {
+		t.Fatalf("expected OperationResultCreated, got %s", result)
+	}
+}
+
+func TestCreateOrPatchIsNoopWhenMutateMakesNoChange(t *testing.T) {
+	existing := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"},
+		Spec: appsv1.DeploymentSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}},
+			Template: corev1.PodTemplateSpec{ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "web"}}},
+		},
+	}
+	r := &BaseReconciler{Client: testClient(t, existing)}
+
+	dep := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"}}
+	result, err := r.CreateOrPatch(context.Background(), dep, func() error { return nil })
+	if err != nil {
+		t.Fatalf("CreateOrPatch returned error: %v", err)
+	}
+	if result != OperationResultNone {
+		t.Fatalf("expected OperationResultNone, got %s", result)
+	}
+}
+
+func TestCreateOrPatchDoesNotTouchFieldsMutateLeavesAlone(t *testing.T) {
+	replicas := int32(5)
+	existing := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: &replicas,
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}},
+			Template: corev1.PodTemplateSpec{ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "web"}}},
+		},
+	}
+	r := &BaseReconciler{Client: testClient(t, existing)}
+
+	dep := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"}}
+	result, err := r.CreateOrPatch(context.Background(), dep, func() error {
+		dep.Spec.Template.ObjectMeta.Labels["app"] = "web-v2"
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("CreateOrPatch returned error: %v", err)
+	}
+	if result != OperationResultUpdated {
+		t.Fatalf("expected OperationResultUpdated, got %s", result)
+	}
+	if dep.Spec.Replicas == nil || *dep.Spec.Replicas != 5 {
+		t.Fatalf("expected Replicas to remain untouched at 5, got %v", dep.Spec.Replicas)
+	}
+}
+
+// testMetadataReconciler builds a BaseReconciler whose MetadataClient/RESTMapper
+// are wired up the way builder.OnlyMetadata-watching controllers wire them,
+// seeded with objs (which must be *metav1.PartialObjectMetadata - see
+// NewSimpleMetadataClient).
+func testMetadataReconciler(t *testing.T, objs ...runtime.Object) *BaseReconciler {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	if err := appsv1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to register appsv1: %v", err)
+	}
+	if err := metav1.AddMetaToScheme(scheme); err != nil {
+		t.Fatalf("failed to register metav1: %v", err)
+	}
+
+	mapper := meta.NewDefaultRESTMapper([]schema.GroupVersion{appsv1.SchemeGroupVersion})
+	mapper.Add(appsv1.SchemeGroupVersion.WithKind("Deployment"), meta.RESTScopeNamespace)
+
+	return &BaseReconciler{
+		MetadataClient: metadatafake.NewSimpleMetadataClient(scheme, objs...),
+		RESTMapper:     mapper,
+	}
+}
+
+func partialDeployment(name, namespace string) *metav1.PartialObjectMetadata {
+	return &metav1.PartialObjectMetadata{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+	}
+}
+
+func TestGetObjectHydratesPartialObjectMetadataViaMetadataClient(t *testing.T) {
+	existing := partialDeployment("web", "default")
+	existing.Labels = map[string]string{"app": "web"}
+	r := testMetadataReconciler(t, existing)
+
+	obj := partialDeployment("web", "default")
+	if err := r.GetObject(context.Background(), client.ObjectKey{Name: "web", Namespace: "default"}, obj); err != nil {
+		t.Fatalf("GetObject returned error: %v", err)
+	}
+	if obj.Labels["app"] != "web" {
+		t.Fatalf("expected GetObject to populate Labels via MetadataClient, got %v", obj.Labels)
+	}
+}
+
+func TestGetObjectIgnoresNotFoundForPartialObjectMetadata(t *testing.T) {
+	r := testMetadataReconciler(t)
+
+	obj := partialDeployment("missing", "default")
+	if err := r.GetObject(context.Background(), client.ObjectKey{Name: "missing", Namespace: "default"}, obj); err != nil {
+		t.Fatalf("GetObject returned error: %v", err)
+	}
+}
+
+func TestDeleteIfExistsUsesMetadataClientForPartialObjectMetadata(t *testing.T) {
+	r := testMetadataReconciler(t, partialDeployment("web", "default"))
+
+	if err := r.DeleteIfExists(context.Background(), partialDeployment("web", "default")); err != nil {
+		t.Fatalf("DeleteIfExists returned error: %v", err)
+	}
+
+	res, err := r.metadataResource(partialDeployment("web", "default"))
+	if err != nil {
+		t.Fatalf("metadataResource returned error: %v", err)
+	}
+	if _, err := res.Get(context.Background(), "web", metav1.GetOptions{}); !errors.IsNotFound(err) {
+		t.Fatalf("expected the Deployment to be deleted, got: %v", err)
+	}
+}
+
+func TestDeleteIfExistsIsNoopWhenAlreadyGone(t *testing.T) {
+	r := testMetadataReconciler(t)
+
+	if err := r.DeleteIfExists(context.Background(), partialDeployment("web", "default")); err != nil {
+		t.Fatalf("DeleteIfExists returned error: %v", err)
+	}
+}
+
+func TestPatchMetadataObjectPatchesViaMetadataClient(t *testing.T) {
+	r := testMetadataReconciler(t, partialDeployment("web", "default"))
+
+	obj := partialDeployment("web", "default")
+	patch := []byte(`{"metadata":{"labels":{"app":"web"}}}`)
+	if err := r.PatchMetadataObject(context.Background(), obj, types.MergePatchType, patch); err != nil {
+		t.Fatalf("PatchMetadataObject returned error: %v", err)
+	}
+	if obj.Labels["app"] != "web" {
+		t.Fatalf("expected obj to be updated in place with the patched Labels, got %v", obj.Labels)
+	}
+}
+
+func TestPatchMetadataObjectRequiresMetadataClient(t *testing.T) {
+	r := &BaseReconciler{}
+
+	if err := r.PatchMetadataObject(context.Background(), partialDeployment("web", "default"), types.MergePatchType, []byte(`{}`)); err == nil {
+		t.Fatal("expected an error when MetadataClient is unset")
+	}
+}
+
+func TestListMetadataObjectsListsViaMetadataClient(t *testing.T) {
+	r := testMetadataReconciler(t, partialDeployment("web", "default"), partialDeployment("worker", "default"))
+
+	list, err := r.ListMetadataObjects(context.Background(), partialDeployment("", "default"), metav1.ListOptions{})
+	if err != nil {
+		t.Fatalf("ListMetadataObjects returned error: %v", err)
+	}
+	if len(list.Items) != 2 {
+		t.Fatalf("expected 2 items, got %d", len(list.Items))
+	}
+}
+
+func TestListMetadataObjectsRequiresMetadataClient(t *testing.T) {
+	r := &BaseReconciler{}
+
+	if _, err := r.ListMetadataObjects(context.Background(), partialDeployment("", "default"), metav1.ListOptions{}); err == nil {
+		t.Fatal("expected an error when MetadataClient is unset")
+	}
+}
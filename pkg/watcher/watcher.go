@@ -3,12 +3,17 @@ package watcher
 import (
 	"context"
 	"fmt"
+	"sync/atomic"
 	"time"
 
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/watch"
+	metadataclient "k8s.io/client-go/metadata"
+	"k8s.io/client-go/metadata/metadatainformer"
+	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log"
@@ -23,6 +28,10 @@ const (
 	EventError    EventType = "ERROR"
 )
 
+// defaultEventBufferSize is how many Events() the channel holds before Start
+// begins dropping them rather than blocking the informer's delivery loop.
+const defaultEventBufferSize = 100
+
 type Event struct {
 	Type   EventType
 	Object runtime.Object
@@ -34,53 +43,191 @@ type EventHandler interface {
 	OnDelete(obj interface{})
 }
 
+// Stats reports how many events a ResourceWatcher has delivered on its
+// Events() channel versus dropped because no one was reading fast enough.
+type Stats struct {
+	Delivered uint64
+	Dropped   uint64
+}
+
+// ResourceWatcher runs a single cache.SharedIndexInformer for one resource
+// type and fans its events out to registered EventHandlers and a typed
+// Events() channel. It supports two modes, chosen by which option is passed
+// to NewResourceWatcher: full-typed, built from a client-go REST client via
+// WithRESTClient, or metadata-only, built from a client-go metadata.Interface
+// via MetadataOnly.
 type ResourceWatcher struct {
-	client   client.Client
+	obj      client.Object
 	informer cache.SharedIndexInformer
 	stopCh   chan struct{}
 	handlers []EventHandler
+
+	events    chan Event
+	delivered atomic.Uint64
+	dropped   atomic.Uint64
+}
+
+// Option configures a ResourceWatcher at construction time.
+type Option func(*resourceWatcherConfig)
+
+type resourceWatcherConfig struct {
+	namespace       string
+	labelSelector   string
+	fieldSelector   string
+	eventBufferSize int
+
+	metadataOnly   bool
+	metadataClient metadataclient.Interface
+	gvr            schema.GroupVersionResource
+
+	restClient rest.Interface
+	resource   string
+}
+
+// WithNamespace restricts the watch to a single namespace. The default is all
+// namespaces.
+func WithNamespace(namespace string) Option {
+	return func(c *resourceWatcherConfig) { c.namespace = namespace }
 }
 
-func NewResourceWatcher(c client.Client, obj client.Object, resyncPeriod time.Duration) *ResourceWatcher {
-	return &ResourceWatcher{
-		client:   c,
+// WithLabelSelector restricts the watch to objects matching selector.
+func WithLabelSelector(selector string) Option {
+	return func(c *resourceWatcherConfig) { c.labelSelector = selector }
+}
+
+// WithFieldSelector restricts the watch to objects matching selector.
+func WithFieldSelector(selector string) Option {
+	return func(c *resourceWatcherConfig) { c.fieldSelector = selector }
+}
+
+// WithEventBufferSize overrides the Events() channel's buffer size. The
+// default is defaultEventBufferSize.
+func WithEventBufferSize(size int) Option {
+	return func(c *resourceWatcherConfig) { c.eventBufferSize = size }
+}
+
+// WithRESTClient builds the watcher's informer from restClient in full-typed
+// form, i.e. the informer's store holds the object passed to
+// NewResourceWatcher, not a PartialObjectMetadata. restClient is normally one
+// of a client-go typed clientset's per-group clients, e.g.
+// clientset.AppsV1().RESTClient(), and resource is that group's plural
+// resource name, e.g. "deployments".
+func WithRESTClient(restClient rest.Interface, resource string) Option {
+	return func(c *resourceWatcherConfig) {
+		c.restClient = restClient
+		c.resource = resource
+	}
+}
+
+// MetadataOnly builds the watcher's informer from metav1.PartialObjectMetadata
+// objects instead of the typed form, using metadataClient to list/watch gvr.
+// This is the ResourceWatcher equivalent of builder.OnlyMetadata in
+// pkg/builder: it trades the ability to read spec/status for a much smaller
+// cache footprint, which matters when watching many Pods/ConfigMaps purely to
+// notice that something changed.
+func MetadataOnly(metadataClient metadataclient.Interface, gvr schema.GroupVersionResource) Option {
+	return func(c *resourceWatcherConfig) {
+		c.metadataOnly = true
+		c.metadataClient = metadataClient
+		c.gvr = gvr
+	}
+}
+
+// NewResourceWatcher builds a ResourceWatcher for obj's type. Exactly one of
+// MetadataOnly or WithRESTClient must be passed to select how the underlying
+// informer is built; NewResourceWatcher returns an error otherwise.
+func NewResourceWatcher(obj client.Object, resyncPeriod time.Duration, opts ...Option) (*ResourceWatcher, error) {
+	cfg := &resourceWatcherConfig{eventBufferSize: defaultEventBufferSize}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	w := &ResourceWatcher{
+		obj:      obj,
 		stopCh:   make(chan struct{}),
 		handlers: []EventHandler{},
+		events:   make(chan Event, cfg.eventBufferSize),
+	}
+
+	listOptionsFunc := func(options *metav1.ListOptions) {
+		options.LabelSelector = cfg.labelSelector
+		options.FieldSelector = cfg.fieldSelector
 	}
+
+	if cfg.metadataOnly && cfg.restClient != nil {
+		return nil, fmt.Errorf("NewResourceWatcher accepts only one of MetadataOnly or WithRESTClient")
+	}
+
+	switch {
+	case cfg.metadataOnly:
+		if cfg.metadataClient == nil {
+			return nil, fmt.Errorf("MetadataOnly requires a non-nil metadata client")
+		}
+		factory := metadatainformer.NewFilteredSharedInformerFactory(cfg.metadataClient, resyncPeriod, cfg.namespace, listOptionsFunc)
+		w.informer = factory.ForResource(cfg.gvr).Informer()
+	case cfg.restClient != nil:
+		lw := cache.NewFilteredListWatchFromClient(cfg.restClient, cfg.resource, cfg.namespace, listOptionsFunc)
+		w.informer = cache.NewSharedIndexInformer(lw, obj.DeepCopyObject(), resyncPeriod, cache.Indexers{})
+	default:
+		return nil, fmt.Errorf("NewResourceWatcher requires either MetadataOnly or WithRESTClient to build an informer")
+	}
+
+	return w, nil
 }
 
 func (w *ResourceWatcher) AddEventHandler(handler EventHandler) {
 	w.handlers = append(w.handlers, handler)
 }
 
-func (w *ResourceWatcher) Start(ctx context.Context) error {
+// Events returns the channel ResourceWatcher delivers events on. Delivery is
+// non-blocking: if the reader falls behind and the buffer fills, further
+// events are dropped and counted in Stats() rather than stalling the
+// informer.
+func (w *ResourceWatcher) Events() <-chan Event {
+	return w.events
+}
+
+// Stats reports the Events() delivery/drop counters accumulated so far.
+func (w *ResourceWatcher) Stats() Stats {
+	return Stats{
+		Delivered: w.delivered.Load(),
+		Dropped:   w.dropped.Load(),
+	}
+}
+
+func (w *ResourceWatcher) Start(ctx context.Context, cacheSyncTimeout time.Duration) error {
 	logger := log.FromContext(ctx)
 	logger.Info("Starting resource watcher")
 
-	if w.informer != nil {
-		w.informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
-			AddFunc: func(obj interface{}) {
-				for _, h := range w.handlers {
-					h.OnAdd(obj)
-				}
-			},
-			UpdateFunc: func(oldObj, newObj interface{}) {
-				for _, h := range w.handlers {
-					h.OnUpdate(oldObj, newObj)
-				}
-			},
-			DeleteFunc: func(obj interface{}) {
-				for _, h := range w.handlers {
-					h.OnDelete(obj)
-				}
-			},
-		})
-
-		go w.informer.Run(w.stopCh)
-
-		if !cache.WaitForCacheSync(w.stopCh, w.informer.HasSynced) {
-			return fmt.Errorf("failed to sync cache")
-		}
+	w.informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			for _, h := range w.handlers {
+				h.OnAdd(obj)
+			}
+			w.deliver(EventAdded, obj)
+		},
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			for _, h := range w.handlers {
+				h.OnUpdate(oldObj, newObj)
+			}
+			w.deliver(EventModified, newObj)
+		},
+		DeleteFunc: func(obj interface{}) {
+			obj = unwrapDeletedFinalStateUnknown(obj)
+			for _, h := range w.handlers {
+				h.OnDelete(obj)
+			}
+			w.deliver(EventDeleted, obj)
+		},
+	})
+
+	go w.informer.Run(w.stopCh)
+
+	syncCtx, cancel := context.WithTimeout(ctx, cacheSyncTimeout)
+	defer cancel()
+
+	if !cache.WaitForCacheSync(syncCtx.Done(), w.informer.HasSynced) {
+		return fmt.Errorf("failed to sync cache within %s", cacheSyncTimeout)
 	}
 
 	return nil
@@ -90,6 +237,29 @@ func (w *ResourceWatcher) Stop() {
 	close(w.stopCh)
 }
 
+// deliver pushes an event onto Events() without blocking, counting it as
+// dropped if the buffer is full.
+func (w *ResourceWatcher) deliver(eventType EventType, obj interface{}) {
+	robj, ok := obj.(runtime.Object)
+	if !ok {
+		return
+	}
+
+	select {
+	case w.events <- Event{Type: eventType, Object: robj}:
+		w.delivered.Add(1)
+	default:
+		w.dropped.Add(1)
+	}
+}
+
+func unwrapDeletedFinalStateUnknown(obj interface{}) interface{} {
+	if d, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		return d.Obj
+	}
+	return obj
+}
+
 type SimpleEventHandler struct {
 	OnAddFunc    func(obj interface{})
 	OnUpdateFunc func(oldObj, newObj interface{})
@@ -128,9 +298,9 @@ func (wm *WatchManager) AddWatcher(name string, watcher *ResourceWatcher) {
 	wm.watchers[name] = watcher
 }
 
-func (wm *WatchManager) StartAll(ctx context.Context) error {
+func (wm *WatchManager) StartAll(ctx context.Context, cacheSyncTimeout time.Duration) error {
 	for name, watcher := range wm.watchers {
-		if err := watcher.Start(ctx); err != nil {
+		if err := watcher.Start(ctx, cacheSyncTimeout); err != nil {
 			return fmt.Errorf("failed to start watcher %s: %w", name, err)
 		}
 	}
@@ -187,5 +357,3 @@ func GetObjectNamespace(obj interface{}) string {
 	}
 	return meta.GetNamespace()
 }
-
-// Made with Bob
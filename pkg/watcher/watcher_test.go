@@ -0,0 +1,90 @@
+package watcher
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	metadatafake "k8s.io/client-go/metadata/fake"
+)
+
+func TestNewResourceWatcherRequiresAMode(t *testing.T) {
+	_, err := NewResourceWatcher(&corev1.ConfigMap{}, time.Second)
+	if err == nil {
+		t.Fatal("expected an error when neither MetadataOnly nor WithRESTClient is passed")
+	}
+}
+
+func TestNewResourceWatcherMetadataOnlyBuildsInformer(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := metav1.AddMetaToScheme(scheme); err != nil {
+		t.Fatalf("failed to register PartialObjectMetadata: %v", err)
+	}
+	gvr := schema.GroupVersionResource{Version: "v1", Resource: "configmaps"}
+
+	fakeClient := metadatafake.NewSimpleMetadataClient(scheme, &metav1.PartialObjectMetadata{
+		TypeMeta:   metav1.TypeMeta{Kind: "ConfigMap", APIVersion: "v1"},
+		ObjectMeta: metav1.ObjectMeta{Name: "my-config", Namespace: "default"},
+	})
+
+	w, err := NewResourceWatcher(&corev1.ConfigMap{}, time.Second, MetadataOnly(fakeClient, gvr))
+	if err != nil {
+		t.Fatalf("NewResourceWatcher returned error: %v", err)
+	}
+
+	if w.informer == nil {
+		t.Fatal("expected MetadataOnly to construct an informer, got nil")
+	}
+}
+
+func TestResourceWatcherDeliversEventsAndDropsWhenFull(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := metav1.AddMetaToScheme(scheme); err != nil {
+		t.Fatalf("failed to register PartialObjectMetadata: %v", err)
+	}
+	gvr := schema.GroupVersionResource{Version: "v1", Resource: "configmaps"}
+	fakeClient := metadatafake.NewSimpleMetadataClient(scheme)
+
+	w, err := NewResourceWatcher(&corev1.ConfigMap{}, time.Second, MetadataOnly(fakeClient, gvr), WithEventBufferSize(1))
+	if err != nil {
+		t.Fatalf("NewResourceWatcher returned error: %v", err)
+	}
+
+	for i := 0; i < 3; i++ {
+		w.deliver(EventAdded, &metav1.PartialObjectMetadata{})
+	}
+
+	stats := w.Stats()
+	if stats.Delivered != 1 {
+		t.Fatalf("expected 1 delivered event, got %d", stats.Delivered)
+	}
+	if stats.Dropped != 2 {
+		t.Fatalf("expected 2 dropped events, got %d", stats.Dropped)
+	}
+	if len(w.Events()) != 1 {
+		t.Fatalf("expected 1 buffered event, got %d", len(w.Events()))
+	}
+}
+
+func TestResourceWatcherStartWaitsForCacheSync(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := metav1.AddMetaToScheme(scheme); err != nil {
+		t.Fatalf("failed to register PartialObjectMetadata: %v", err)
+	}
+	gvr := schema.GroupVersionResource{Version: "v1", Resource: "configmaps"}
+	fakeClient := metadatafake.NewSimpleMetadataClient(scheme)
+
+	w, err := NewResourceWatcher(&corev1.ConfigMap{}, time.Second, MetadataOnly(fakeClient, gvr))
+	if err != nil {
+		t.Fatalf("NewResourceWatcher returned error: %v", err)
+	}
+
+	if err := w.Start(context.Background(), time.Second); err != nil {
+		t.Fatalf("expected cache to sync against a fake client, got error: %v", err)
+	}
+	w.Stop()
+}